@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// AllocationStrategy picks the seat of a new booking when the passenger has
+// not asked for a specific one
+type AllocationStrategy interface {
+	// Pick returns one of seats, which are vacant for the whole journey,
+	// match the passenger's preferences and are never empty. They are in
+	// allocation order: section by section in layout order.
+	Pick(seats []Seat) Seat
+}
+
+// Allocation strategies selectable with -allocation
+var allocationStrategies = map[string]AllocationStrategy{
+	"first-fit":        firstFit{},
+	"balance-sections": balanceSections{},
+	"fill-from-back":   fillFromBack{},
+	"random":           randomSeat{},
+}
+
+// AllocationStrategyByName returns the allocation strategy called name
+func AllocationStrategyByName(name string) (AllocationStrategy, error) {
+	strategy, ok := allocationStrategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown allocation strategy %q", name)
+	}
+	return strategy, nil
+}
+
+// firstFit fills the train from the front: the first seat in allocation order
+type firstFit struct{}
+
+func (firstFit) Pick(seats []Seat) Seat {
+	return seats[0]
+}
+
+// balanceSections spreads passengers over the sections: the first seat of
+// the section with the most seats to choose from
+type balanceSections struct{}
+
+func (balanceSections) Pick(seats []Seat) Seat {
+	counts := make(map[string]int)
+	for _, seat := range seats {
+		counts[seat.Section]++
+	}
+	best := seats[0]
+	for _, seat := range seats {
+		if counts[seat.Section] > counts[best.Section] {
+			best = seat
+		}
+	}
+	return best
+}
+
+// fillFromBack fills the train from the back: the last seat in allocation order
+type fillFromBack struct{}
+
+func (fillFromBack) Pick(seats []Seat) Seat {
+	return seats[len(seats)-1]
+}
+
+// randomSeat picks any of the seats
+type randomSeat struct{}
+
+func (randomSeat) Pick(seats []Seat) Seat {
+	return seats[rand.IntN(len(seats))]
+}
+
+// seatPreference is where a passenger would like to sit. section and seat
+// must be met; position and nearBookingID are honoured when a matching seat
+// is free.
+type seatPreference struct {
+	section       string
+	seat          string
+	position      seatPosition
+	nearBookingID string // Sit as close as possible to this booking
+}
+
+// seatPosition is the kind of seat a passenger prefers within a row
+type seatPosition int
+
+const (
+	positionAny seatPosition = iota
+	positionWindow
+	positionAisle
+)
+
+// Helper function to narrow the vacant seats to those matching the soft
+// preferences: the requested position if any such seat is vacant, then the
+// seats closest to the companion's seat if one is given
+func preferredSeats(seats []Seat, position seatPosition, companion *Seat) []Seat {
+	if position != positionAny {
+		var matching []Seat
+		for _, seat := range seats {
+			if (position == positionWindow && seat.Window) || (position == positionAisle && seat.Aisle) {
+				matching = append(matching, seat)
+			}
+		}
+		if len(matching) > 0 {
+			seats = matching
+		}
+	}
+
+	if companion != nil {
+		var closest []Seat
+		best := -1
+		for _, seat := range seats {
+			d := seatDistance(*companion, seat)
+			if best < 0 || d < best {
+				closest, best = nil, d
+			}
+			if d == best {
+				closest = append(closest, seat)
+			}
+		}
+		seats = closest
+	}
+	return seats
+}
+
+// seatDistance is how far apart two seats are, counting rows and columns;
+// seats of different sections are further apart than any two of one section
+func seatDistance(a, b Seat) int {
+	if a.Section != b.Section {
+		return 1 << 30
+	}
+	return abs(a.Row-b.Row) + abs(a.Column-b.Column)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
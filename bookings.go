@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// allocation is a seat picked for a new booking, before it is stored
+type allocation struct {
+	departure *Departure
+	inv       *inventory
+	from, to  string
+	journey   legs
+	section   *SectionLayout
+	seat      Seat
+	fare      Money
+}
+
+// Helper function to pick a seat for a new booking: the seat named by the
+// preference if there is one, otherwise the one the allocation strategy
+// picks among the seats vacant on every leg of the journey in the requested
+// travel class and section, narrowed by the position and companion preferences
+func (s *server) allocate(departureID, from, to, travelClass string, pref seatPreference) (*allocation, error) {
+	departure, inv, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+	a := &allocation{departure: departure, inv: inv}
+
+	if a.from, a.to, a.journey, err = resolveJourney(departure, from, to); err != nil {
+		return nil, err
+	}
+	if travelClass != "" && !inv.layout.HasClass(travelClass) {
+		return nil, invalidArgumentError("travel_class", "no section of the departure has this travel class")
+	}
+
+	if pref.seat != "" {
+		var ok bool
+		if a.seat, ok = inv.layout.Seat(pref.seat); !ok {
+			return nil, invalidArgumentError("seat", "invalid seat number")
+		}
+		a.section, _ = inv.layout.Section(a.seat.Section)
+		if travelClass != "" && a.section.Class != travelClass {
+			return nil, invalidArgumentError("seat", "seat is not of the requested travel class")
+		}
+		if inv.blocked[a.seat.Label] {
+			return nil, invalidArgumentError("seat", "seat is not on sale")
+		}
+		if inv.occupant(a.seat, a.journey) != "" {
+			return nil, alreadyExistsError(resourceSeat, a.seat.Label, "the requested seat is already taken")
+		}
+	} else {
+		if pref.section != "" {
+			section, ok := inv.layout.Section(pref.section)
+			if !ok {
+				return nil, invalidArgumentError("section", "invalid section")
+			}
+			if travelClass != "" && section.Class != travelClass {
+				return nil, invalidArgumentError("section", "section is not of the requested travel class")
+			}
+		}
+		companion, err := s.companionSeat(departure, pref.nearBookingID)
+		if err != nil {
+			return nil, err
+		}
+
+		var seats []Seat
+		for _, section := range inv.layout.Sections {
+			if (travelClass != "" && section.Class != travelClass) || (pref.section != "" && section.Name != pref.section) {
+				continue
+			}
+			seats = append(seats, inv.vacantSeats(section, a.journey)...)
+		}
+		if len(seats) == 0 {
+			return nil, exhaustedError(resourceDeparture, departure.ID, "no seats available")
+		}
+		a.seat = s.opts.Allocation.Pick(preferredSeats(seats, pref.position, companion))
+		a.section, _ = inv.layout.Section(a.seat.Section)
+	}
+
+	if a.fare, err = s.catalogue.Price(departure, a.section, a.journey); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Helper function to store a new booking on an allocated seat and take the
+// seat. receipt carries what the caller knows of the booking (user, status,
+// hold expiry, payment, group); the booking reference and the journey, seat
+// and fare of the allocation are filled in.
+func (s *server) book(a *allocation, receipt *pb.Receipt) error {
+	bookingID, err := s.newBookingID()
+	if err != nil {
+		return err
+	}
+	receipt.BookingId = bookingID
+	a.assign(receipt)
+	if err := s.store.PutReceipt(bookingID, receipt); err != nil {
+		return err
+	}
+
+	a.inv.occupy(a.seat, a.journey, bookingID)
+	s.byEmail[receipt.User.Email] = append(s.byEmail[receipt.User.Email], bookingID)
+	if receipt.Status == pb.BookingStatus_BOOKING_STATUS_HELD {
+		s.holds[bookingID] = receipt.HoldExpiresAt.AsTime()
+	}
+	if receipt.GroupId != "" {
+		s.groups[receipt.GroupId] = append(s.groups[receipt.GroupId], bookingID)
+	}
+	s.seatChanged(a.departure.ID, a.seat, a.journey)
+	return nil
+}
+
+// Helper function to authorize payment of fare with the client's payment token
+func (s *server) authorize(ctx context.Context, token string, fare Money) (string, error) {
+	transactionID, err := s.payments.Authorize(ctx, token, fare)
+	if err != nil {
+		return "", paymentError(err)
+	}
+	return transactionID, nil
+}
+
+// Helper function to capture the authorized payment of a stored booking and
+// record it on the receipt
+func (s *server) capture(ctx context.Context, receipt *pb.Receipt) error {
+	if err := s.payments.Capture(ctx, receipt.TransactionId); err != nil {
+		return paymentError(err)
+	}
+	receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_CAPTURED
+	return s.store.PutReceipt(receipt.BookingId, receipt)
+}
+
+// Helper function to give back fare of a payment that no booking was stored
+// for, whether it was only authorized or already captured
+func (s *server) releasePayment(ctx context.Context, transactionID string, fare Money) error {
+	return s.payments.Refund(ctx, transactionID, fare)
+}
+
+// Helper function to undo a purchase whose bookings could not all be stored
+// as paid: the whole payment is given back, whatever the refund policy says,
+// and the bookings made for it are cancelled. A payment the gateway does not
+// give back stays on the bookings and is logged for settling by hand. The
+// client gets the original error, so failures are only logged.
+func (s *server) abandonPurchase(ctx context.Context, receipts []*pb.Receipt, transactionID string, total Money) {
+	released := s.releasePayment(ctx, transactionID, total)
+	if released != nil {
+		log.Printf("Failed to give back %s of payment %s, settle it by hand: %v", total, transactionID, released)
+	}
+	now := time.Now()
+	for _, receipt := range receipts {
+		switch {
+		case released != nil:
+			log.Printf("Cancelling booking %s with payment %s still %s", receipt.BookingId, transactionID, receipt.PaymentStatus)
+		case receipt.PaymentStatus == pb.PaymentStatus_PAYMENT_STATUS_CAPTURED:
+			receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_REFUNDED
+			receipt.Refund = receipt.Price
+		default:
+			// The authorization lapsed without anything being collected
+			receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+		}
+		if err := s.closeBooking(ctx, receipt, now); err != nil {
+			log.Printf("Failed to cancel booking %s of an abandoned purchase: %v", receipt.BookingId, err)
+		}
+	}
+}
+
+// refundPart is the share of a refund paid back on one transaction
+type refundPart struct {
+	transactionID string
+	amount        Money
+}
+
+// Helper function to pay back amount of a booking's payment
+func (s *server) refundPayment(ctx context.Context, receipt *pb.Receipt, amount Money) error {
+	parts, _, err := planRefund(receipt, amount)
+	if err != nil {
+		return err
+	}
+	return s.issueRefund(ctx, receipt.BookingId, parts)
+}
+
+// Helper function to split a refund of a booking's payment over its
+// transactions. It comes out of the latest fare adjustments first, then out
+// of the original payment; the adjustments record what is taken from them.
+// Whatever the booking's transactions cannot cover, as for bookings from
+// before payments were taken, is returned as uncovered.
+func planRefund(receipt *pb.Receipt, amount Money) (parts []refundPart, uncovered Money, err error) {
+	for i := len(receipt.FareAdjustments) - 1; i >= 0 && amount.Amount > 0; i-- {
+		adjustment := receipt.FareAdjustments[i]
+		paid, err := MoneyFromProto(adjustment.Amount)
+		if err != nil {
+			return nil, Money{}, err
+		}
+		refunded, err := MoneyFromProto(adjustment.Refunded)
+		if err != nil {
+			return nil, Money{}, err
+		}
+		part := Money{Currency: amount.Currency, Amount: min(amount.Amount, paid.Amount-refunded.Amount)}
+		if part.Amount <= 0 {
+			continue
+		}
+		parts = append(parts, refundPart{transactionID: adjustment.TransactionId, amount: part})
+		refunded.Amount += part.Amount
+		adjustment.Refunded = refunded.Proto()
+		amount.Amount -= part.Amount
+	}
+	if amount.Amount > 0 && receipt.TransactionId != "" {
+		parts = append(parts, refundPart{transactionID: receipt.TransactionId, amount: amount})
+		amount.Amount = 0
+	}
+	return parts, amount, nil
+}
+
+// Helper function to pay back the parts of a refund. Parts paid back before
+// one fails cannot be taken back, so they are logged for settling by hand.
+func (s *server) issueRefund(ctx context.Context, bookingID string, parts []refundPart) error {
+	for i, part := range parts {
+		if err := s.payments.Refund(ctx, part.transactionID, part.amount); err != nil {
+			for _, done := range parts[:i] {
+				log.Printf("Refunded %s on %s for booking %s before a later refund failed", done.amount, done.transactionID, bookingID)
+			}
+			return paymentError(err)
+		}
+	}
+	return nil
+}
+
+// Helper function to pay for a new booking: the fare is authorized before
+// the booking is stored and captured after, and a booking whose payment
+// cannot be captured is cancelled with the payment given back in full
+func (s *server) purchase(ctx context.Context, a *allocation, user *pb.User, token string) (*pb.Receipt, error) {
+	transactionID, err := s.authorize(ctx, token, a.fare)
+	if err != nil {
+		return nil, err
+	}
+	receipt := &pb.Receipt{
+		User:          user,
+		PaymentStatus: pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+		TransactionId: transactionID,
+	}
+	if err := s.book(a, receipt); err != nil {
+		s.abandonPurchase(ctx, nil, transactionID, a.fare)
+		return nil, err
+	}
+	if err := s.capture(ctx, receipt); err != nil {
+		s.abandonPurchase(ctx, []*pb.Receipt{receipt}, transactionID, a.fare)
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// Helper function to find the seat of the booking a passenger wants to sit
+// near, or nil if none is given
+func (s *server) companionSeat(departure *Departure, bookingID string) (*Seat, error) {
+	if bookingID == "" {
+		return nil, nil
+	}
+	receipt, err := s.booking(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.DepartureId != departure.ID {
+		return nil, invalidArgumentError("near_booking_id", "booking is on another departure")
+	}
+	if receipt.Status != pb.BookingStatus_BOOKING_STATUS_CONFIRMED && receipt.Status != pb.BookingStatus_BOOKING_STATUS_HELD {
+		return nil, invalidArgumentError("near_booking_id", "booking has no seat")
+	}
+	seat, _ := s.trains[departure.ID].layout.Seat(receipt.Seat)
+	return &seat, nil
+}
+
+// assign fills in the journey, seat and fare of the allocation on receipt
+func (a *allocation) assign(receipt *pb.Receipt) {
+	receipt.From = a.from
+	receipt.To = a.to
+	receipt.PricePaid = a.fare.Float32()
+	receipt.Price = a.fare.Proto()
+	receipt.Seat = a.seat.Label
+	receipt.DepartureId = a.departure.ID
+	receipt.TravelClass = a.section.Class
+	if !a.departure.DepartsAt.IsZero() {
+		receipt.DepartsAt = timestamppb.New(a.departure.DepartsAt)
+	}
+}
+
+// newBookingID returns an unused booking or group reference such as "K7QX2M4P"
+func (s *server) newBookingID() (string, error) {
+	for {
+		var b [5]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return "", err
+		}
+		id := base32.StdEncoding.EncodeToString(b[:])
+		if s.groups[id] != nil {
+			continue
+		}
+
+		if _, err := s.store.Receipt(id); errors.Is(err, ErrNotFound) {
+			return id, nil
+		} else if err != nil {
+			return "", err
+		}
+	}
+}
+
+// Helper function to load a booking by its reference
+func (s *server) booking(bookingID string) (*pb.Receipt, error) {
+	receipt, err := s.store.Receipt(bookingID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError(resourceBooking, bookingID, "booking not found")
+	}
+	return receipt, err
+}
+
+// getBooking returns the receipt of a booking
+func (s *server) getBooking(bookingID string) (*pb.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.booking(bookingID)
+}
+
+// cancel cancels a booking and frees its seat. The receipt returned
+// carries the refund if the booking was paid for.
+func (s *server) cancel(ctx context.Context, bookingID string) (*pb.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.booking(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == pb.BookingStatus_BOOKING_STATUS_CANCELLED {
+		return nil, preconditionError(preconditionCancelled, bookingID, "booking is already cancelled")
+	}
+	if err := s.cancelBooking(ctx, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// modifyBooking moves a booking to another seat if the new seat is available
+func (s *server) modifyBooking(bookingID, newSeat string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.booking(bookingID)
+	if err != nil {
+		return err
+	}
+	switch receipt.Status {
+	case pb.BookingStatus_BOOKING_STATUS_CANCELLED:
+		return preconditionError(preconditionCancelled, bookingID, "booking is cancelled")
+	case pb.BookingStatus_BOOKING_STATUS_WAITLISTED:
+		return preconditionError(preconditionWaitlisted, bookingID, "booking has no seat yet")
+	}
+	return s.moveBooking(receipt, newSeat)
+}
+
+// userBookings returns every booking held by a user, earliest departure first
+func (s *server) userBookings(email string) ([]*pb.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var receipts []*pb.Receipt
+	for _, id := range s.byEmail[email] {
+		receipt, err := s.store.Receipt(id)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	sort.SliceStable(receipts, func(i, j int) bool {
+		a, b := receipts[i].DepartsAt.AsTime(), receipts[j].DepartsAt.AsTime()
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return receipts[i].BookingId < receipts[j].BookingId
+	})
+	return receipts, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+func TestPurchaseRefundedInFullWhenNotStored(t *testing.T) {
+	user := &pb.User{FirstName: "Test", LastName: "User", Email: "user@example.com"}
+	purchase := func(srv *server) (*pb.Receipt, error) {
+		return srv.purchaseTicket(context.Background(), ticketOrder{departureID: "D", user: user})
+	}
+	confirm := func(srv *server) (*pb.Receipt, error) {
+		held, err := srv.holdSeat(ticketOrder{departureID: "D", user: user})
+		if err != nil {
+			return nil, err
+		}
+		return srv.confirmHold(context.Background(), held.BookingId, "", nil)
+	}
+
+	tests := []struct {
+		name       string
+		pay        func(srv *server) (*pb.Receipt, error)
+		failAt     int // Store write that fails
+		wantStatus pb.BookingStatus
+		wantPaid   pb.PaymentStatus
+	}{
+		{
+			name:       "purchase stored as paid",
+			pay:        purchase,
+			failAt:     2,
+			wantStatus: pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+			wantPaid:   pb.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+		},
+		{
+			name:       "hold stored as confirmed",
+			pay:        confirm,
+			failAt:     2,
+			wantStatus: pb.BookingStatus_BOOKING_STATUS_HELD,
+		},
+		{
+			name:       "hold stored as paid",
+			pay:        confirm,
+			failAt:     3,
+			wantStatus: pb.BookingStatus_BOOKING_STATUS_CANCELLED,
+			wantPaid:   pb.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Departing within the hour, under a policy that only refunds
+			// cancellations made two days ahead
+			catalogue := testCatalogue(t, &Fare{Route: "R", Base: 20 * nanosPerUnit})
+			catalogue.Departures[0].DepartsAt = time.Now().Add(time.Hour)
+			catalogue.RefundPolicy = []*RefundRule{{Before: Duration(48 * time.Hour), Percent: 100}}
+			store := &failingStore{Store: NewMemoryStore(), failAt: tt.failAt}
+			gateway := NewFakeGateway().(*fakeGateway)
+			srv, err := NewServer(store, catalogue, gateway, logNotifier{}, Options{HoldTTL: time.Minute})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := tt.pay(srv); err == nil {
+				t.Fatal("payment succeeded despite the failed write")
+			}
+			for transactionID, payment := range gateway.payments {
+				if !payment.captured || payment.refunded != payment.amount.Amount {
+					t.Errorf("payment %s of %d left with %d refunded", transactionID, payment.amount.Amount, payment.refunded)
+				}
+			}
+			receipts, err := store.Receipts()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(receipts) != 1 {
+				t.Fatalf("stored %d bookings, want 1", len(receipts))
+			}
+			got := receipts[0]
+			if got.Status != tt.wantStatus || got.PaymentStatus != tt.wantPaid {
+				t.Errorf("booking left %s and %s, want %s and %s", got.Status, got.PaymentStatus, tt.wantStatus, tt.wantPaid)
+			}
+			if tt.wantPaid == pb.PaymentStatus_PAYMENT_STATUS_REFUNDED {
+				if refund, _ := MoneyFromProto(got.Refund); refund.Amount != 20*nanosPerUnit {
+					t.Errorf("refund = %s, want GBP 20.00", refund)
+				}
+			}
+		})
+	}
+}
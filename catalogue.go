@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+)
+
+// DefaultDepartureID is the departure of the default catalogue
+const DefaultDepartureID = "default"
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Catalogue lists the routes the railway runs, their dated departures and
+// fares. Every departure has its own seat inventory, built from a named layout.
+type Catalogue struct {
+	Layouts          map[string]*Layout `json:"layouts"`
+	Routes           []*Route           `json:"routes"`
+	Departures       []*Departure       `json:"departures"`
+	Fares            []*Fare            `json:"fares"`
+	Currency         string             `json:"currency,omitempty"`          // ISO 4217 code of the fares, defaults to GBP
+	DefaultDeparture string             `json:"default_departure,omitempty"` // Used by requests without a departure; defaults to the first departure
+	RefundPolicy     []*RefundRule      `json:"refund_policy,omitempty"`     // Cancellations are refunded in full when empty
+}
+
+// Route is a line served by the railway
+type Route struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Stations []string `json:"stations"` // In travel order
+}
+
+// Departure is one dated service on a route
+type Departure struct {
+	ID        string    `json:"id"`
+	Route     string    `json:"route"`
+	DepartsAt time.Time `json:"departs_at"`
+	Layout    string    `json:"layout"`
+	Blocked   []string  `json:"blocked,omitempty"` // Labels of seats not on sale
+
+	route  *Route
+	layout *Layout
+}
+
+// DefaultCatalogue is the original single train from London to France, with
+// its coach described by layout and every seat costing 20
+func DefaultCatalogue(layout *Layout) *Catalogue {
+	catalogue := &Catalogue{
+		Layouts: map[string]*Layout{"default": layout},
+		Routes: []*Route{
+			{ID: "default", Name: "London to France", Stations: []string{"London", "France"}},
+		},
+		Departures: []*Departure{
+			{ID: DefaultDepartureID, Route: "default", Layout: "default"},
+		},
+		Fares: []*Fare{
+			{Route: "default", Base: 20 * nanosPerUnit},
+		},
+	}
+	if err := catalogue.build(); err != nil {
+		panic(err)
+	}
+	return catalogue
+}
+
+// LoadCatalogue reads a JSON catalogue file
+func LoadCatalogue(path string) (*Catalogue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalogue Catalogue
+	if err := json.Unmarshal(data, &catalogue); err != nil {
+		return nil, fmt.Errorf("parse catalogue %s: %w", path, err)
+	}
+	if err := catalogue.build(); err != nil {
+		return nil, fmt.Errorf("catalogue %s: %w", path, err)
+	}
+	return &catalogue, nil
+}
+
+// build validates the catalogue and links departures to their route and layout
+func (c *Catalogue) build() error {
+	for name, layout := range c.Layouts {
+		if layout.seats == nil {
+			if err := layout.build(); err != nil {
+				return fmt.Errorf("layout %q: %w", name, err)
+			}
+		}
+	}
+
+	if c.Currency == "" {
+		c.Currency = DefaultCurrency
+	}
+	if !currencyPattern.MatchString(c.Currency) {
+		return fmt.Errorf("invalid currency %q", c.Currency)
+	}
+
+	routes := make(map[string]*Route)
+	for _, route := range c.Routes {
+		if route.ID == "" {
+			return errors.New("route without an id")
+		}
+		if routes[route.ID] != nil {
+			return fmt.Errorf("duplicate route %q", route.ID)
+		}
+		if len(route.Stations) < 2 {
+			return fmt.Errorf("route %q needs at least two stations", route.ID)
+		}
+		routes[route.ID] = route
+	}
+
+	if len(c.Departures) == 0 {
+		return errors.New("no departures")
+	}
+	ids := make(map[string]bool)
+	for _, departure := range c.Departures {
+		if departure.ID == "" {
+			return errors.New("departure without an id")
+		}
+		if ids[departure.ID] {
+			return fmt.Errorf("duplicate departure %q", departure.ID)
+		}
+		ids[departure.ID] = true
+		if departure.route = routes[departure.Route]; departure.route == nil {
+			return fmt.Errorf("departure %q runs on unknown route %q", departure.ID, departure.Route)
+		}
+		if departure.layout = c.Layouts[departure.Layout]; departure.layout == nil {
+			return fmt.Errorf("departure %q uses unknown layout %q", departure.ID, departure.Layout)
+		}
+		for _, label := range departure.Blocked {
+			if _, ok := departure.layout.Seat(label); !ok {
+				return fmt.Errorf("departure %q blocks unknown seat %q", departure.ID, label)
+			}
+		}
+		for _, section := range departure.layout.Sections {
+			if _, ok := c.fare(departure.Route, section); !ok {
+				return fmt.Errorf("departure %q has no fare for section %q", departure.ID, section.Name)
+			}
+		}
+	}
+	for _, fare := range c.Fares {
+		if routes[fare.Route] == nil {
+			return fmt.Errorf("fare for unknown route %q", fare.Route)
+		}
+		if fare.Base < 0 || fare.PerLeg < 0 {
+			return fmt.Errorf("fare for route %q is negative", fare.Route)
+		}
+	}
+
+	if err := c.buildRefundPolicy(); err != nil {
+		return err
+	}
+
+	if c.DefaultDeparture == "" {
+		c.DefaultDeparture = c.Departures[0].ID
+	} else if !ids[c.DefaultDeparture] {
+		return fmt.Errorf("unknown default departure %q", c.DefaultDeparture)
+	}
+	return nil
+}
+
+// Departure returns the departure with the given id, or the default
+// departure when id is empty
+func (c *Catalogue) Departure(id string) (*Departure, bool) {
+	if id == "" {
+		id = c.DefaultDeparture
+	}
+	for _, departure := range c.Departures {
+		if departure.ID == id {
+			return departure, true
+		}
+	}
+	return nil, false
+}
+
+// Legs returns the legs of the route travelled between from and to
+func (d *Departure) Legs(from, to string) (legs, bool) {
+	fromIndex, toIndex := d.route.station(from), d.route.station(to)
+	if fromIndex < 0 || toIndex <= fromIndex {
+		return legs{}, false
+	}
+	return legs{from: fromIndex, to: toIndex}, true
+}
+
+// station returns the position of name along the route, or -1
+func (r *Route) station(name string) int {
+	for i, station := range r.Stations {
+		if station == name {
+			return i
+		}
+	}
+	return -1
+}
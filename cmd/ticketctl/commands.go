@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// commands are the subcommands in the order ticketctl help lists them. The
+// server validates the requests, so missing or malformed flags are reported
+// as its field violations.
+var commands = []command{
+	{name: "purchase", summary: "buy a ticket and print the receipt", setup: purchase},
+	{name: "receipt", summary: "print the receipt of a user", setup: receipt},
+	{name: "list-section", summary: "list the passengers seated in a section", setup: listSection},
+	{name: "remove", summary: "remove a user from a departure", setup: remove},
+	{name: "modify-seat", summary: "move a user to another seat", setup: modifySeat},
+}
+
+func purchase(fs *flag.FlagSet) func(context.Context, pb.TicketServiceClient) (proto.Message, error) {
+	req := &pb.PurchaseRequest{User: &pb.User{}, Preference: &pb.SeatPreference{}}
+	fs.StringVar(&req.User.FirstName, "first-name", "", "first name of the passenger")
+	fs.StringVar(&req.User.LastName, "last-name", "", "last name of the passenger")
+	fs.StringVar(&req.User.Email, "email", "", "email of the passenger")
+	fs.StringVar(&req.From, "from", "", "station to board at (with -to, defaults to the whole route)")
+	fs.StringVar(&req.To, "to", "", "station to leave at")
+	fs.StringVar(&req.DepartureId, "departure", "", "departure to travel on (defaults to the catalogue's default departure)")
+	fs.StringVar(&req.TravelClass, "class", "", "travel class, e.g. standard or first (defaults to any)")
+	fs.StringVar(&req.Preference.Section, "section", "", "section to sit in")
+	fs.StringVar(&req.Preference.Seat, "seat", "", "seat to sit in")
+	fs.BoolVar(&req.JoinWaitlist, "waitlist", false, "join the waitlist when the train is full")
+	price := fs.String("price", "", "fare to pay, e.g. 120.00; it must match the fare (optional)")
+	currency := fs.String("currency", "GBP", "currency of -price")
+	token := fs.String("payment-token", "", "payment method token of the payment gateway")
+
+	return func(ctx context.Context, client pb.TicketServiceClient) (proto.Message, error) {
+		if *price != "" {
+			money, err := parseMoney(*price, *currency)
+			if err != nil {
+				return nil, err
+			}
+			req.Price = money
+		}
+		if req.Preference.Section == "" && req.Preference.Seat == "" {
+			req.Preference = nil
+		}
+		if *token != "" {
+			req.Payment = &pb.PaymentMethod{Token: *token}
+		}
+		return client.PurchaseTicket(ctx, req)
+	}
+}
+
+func receipt(fs *flag.FlagSet) func(context.Context, pb.TicketServiceClient) (proto.Message, error) {
+	req := &pb.ReceiptRequest{}
+	fs.StringVar(&req.Email, "email", "", "email of the user")
+	fs.StringVar(&req.DepartureId, "departure", "", "departure of the booking (defaults to the catalogue's default departure)")
+	return func(ctx context.Context, client pb.TicketServiceClient) (proto.Message, error) {
+		return client.GetReceipt(ctx, req)
+	}
+}
+
+func listSection(fs *flag.FlagSet) func(context.Context, pb.TicketServiceClient) (proto.Message, error) {
+	req := &pb.SectionRequest{}
+	fs.StringVar(&req.Section, "section", "", "section to list, e.g. A")
+	fs.StringVar(&req.DepartureId, "departure", "", "departure to list (defaults to the catalogue's default departure)")
+	return func(ctx context.Context, client pb.TicketServiceClient) (proto.Message, error) {
+		return client.GetAllocatedUsers(ctx, req)
+	}
+}
+
+func remove(fs *flag.FlagSet) func(context.Context, pb.TicketServiceClient) (proto.Message, error) {
+	req := &pb.RemoveRequest{}
+	fs.StringVar(&req.Email, "email", "", "email of the user")
+	fs.StringVar(&req.DepartureId, "departure", "", "departure to remove the user from (defaults to the catalogue's default departure)")
+	return func(ctx context.Context, client pb.TicketServiceClient) (proto.Message, error) {
+		return client.RemoveUser(ctx, req)
+	}
+}
+
+func modifySeat(fs *flag.FlagSet) func(context.Context, pb.TicketServiceClient) (proto.Message, error) {
+	req := &pb.ModifyRequest{}
+	fs.StringVar(&req.Email, "email", "", "email of the user")
+	fs.StringVar(&req.NewSeat, "seat", "", "seat to move to, e.g. B1")
+	fs.StringVar(&req.DepartureId, "departure", "", "departure of the booking (defaults to the catalogue's default departure)")
+	return func(ctx context.Context, client pb.TicketServiceClient) (proto.Message, error) {
+		return client.ModifySeat(ctx, req)
+	}
+}
+
+// Helper function to parse a decimal amount such as 120 or 120.50 into
+// Money. Prices are never negative, so a sign is refused.
+func parseMoney(amount, currency string) (*pb.Money, error) {
+	whole, fraction, point := strings.Cut(amount, ".")
+	if !isDigits(whole) || (point && !isDigits(fraction)) || len(fraction) > 9 {
+		return nil, fmt.Errorf("invalid price %q", amount)
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price %q", amount)
+	}
+	nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+	return &pb.Money{CurrencyCode: strings.ToUpper(currency), Units: units, Nanos: int32(nanos)}, nil
+}
+
+// Helper function to tell whether text is one or more decimal digits
+func isDigits(text string) bool {
+	return text != "" && strings.Trim(text, "0123456789") == ""
+}
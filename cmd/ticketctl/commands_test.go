@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount  string
+		want    *pb.Money
+		wantErr bool
+	}{
+		{amount: "120", want: &pb.Money{CurrencyCode: "GBP", Units: 120}},
+		{amount: "120.5", want: &pb.Money{CurrencyCode: "GBP", Units: 120, Nanos: 500_000_000}},
+		{amount: "0.50", want: &pb.Money{CurrencyCode: "GBP", Nanos: 500_000_000}},
+		{amount: "0.000000001", want: &pb.Money{CurrencyCode: "GBP", Nanos: 1}},
+		{amount: "-0.50", wantErr: true},
+		{amount: "-1", wantErr: true},
+		{amount: "+1", wantErr: true},
+		{amount: "1.-5", wantErr: true},
+		{amount: "1.+5", wantErr: true},
+		{amount: "1.", wantErr: true},
+		{amount: ".5", wantErr: true},
+		{amount: "1.0000000001", wantErr: true},
+		{amount: "1,50", wantErr: true},
+		{amount: "99999999999999999999", wantErr: true},
+		{amount: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			got, err := parseMoney(tt.amount, "gbp")
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseMoney(%q) = %v, want an error", tt.amount, got)
+				}
+				return
+			}
+			if err != nil || !proto.Equal(got, tt.want) {
+				t.Errorf("parseMoney(%q) = %v, %v, want %v", tt.amount, got, err, tt.want)
+			}
+		})
+	}
+}
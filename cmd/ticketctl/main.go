@@ -0,0 +1,165 @@
+// Command ticketctl is a command-line client of the ticket.v1 TicketService.
+//
+// Usage:
+//
+//	ticketctl <command> [flags]
+//
+// Run ticketctl help for the commands and ticketctl <command> -h for their flags.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+// command is a subcommand calling one RPC. setup registers the flags of the
+// command and returns the call to make once they are parsed.
+type command struct {
+	name    string
+	summary string
+	setup   func(fs *flag.FlagSet) func(ctx context.Context, client pb.TicketServiceClient) (proto.Message, error)
+}
+
+// options are the connection and output flags shared by every command
+type options struct {
+	addr       string
+	useTLS     bool
+	caCert     string
+	serverName string
+	skipVerify bool
+	timeout    time.Duration
+	output     string
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	addr := os.Getenv("TICKETCTL_ADDR")
+	if addr == "" {
+		addr = "localhost:50051"
+	}
+	fs.StringVar(&o.addr, "addr", addr, "address of the gRPC server (defaults to $TICKETCTL_ADDR when set)")
+	fs.BoolVar(&o.useTLS, "tls", false, "connect with TLS")
+	fs.StringVar(&o.caCert, "ca-cert", "", "PEM file of the CA that signed the server certificate, instead of the system roots (implies -tls)")
+	fs.StringVar(&o.serverName, "server-name", "", "name to verify the server certificate against, instead of the host of -addr (implies -tls)")
+	fs.BoolVar(&o.skipVerify, "insecure-skip-verify", false, "do not verify the server certificate; for testing only (implies -tls)")
+	fs.DurationVar(&o.timeout, "timeout", 10*time.Second, "deadline of the call")
+	fs.StringVar(&o.output, "o", "table", "output format: table, json or yaml")
+}
+
+// dial connects to the server, over TLS when any of the TLS flags is set
+func (o *options) dial() (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if o.useTLS || o.caCert != "" || o.serverName != "" || o.skipVerify {
+		config := &tls.Config{ServerName: o.serverName, InsecureSkipVerify: o.skipVerify}
+		if o.caCert != "" {
+			pem, err := os.ReadFile(o.caCert)
+			if err != nil {
+				return nil, err
+			}
+			config.RootCAs = x509.NewCertPool()
+			if !config.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in %s", o.caCert)
+			}
+		}
+		creds = credentials.NewTLS(config)
+	}
+	return grpc.NewClient(o.addr, grpc.WithTransportCredentials(creds))
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the exit status: 0 on success,
+// 1 when the call fails and 2 on usage errors
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "ticketctl: unknown command %q\n\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("ticketctl "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts options
+	opts.register(fs)
+	call := cmd.setup(fs)
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "ticketctl %s: unexpected argument %q\n", cmd.name, fs.Arg(0))
+		return 2
+	}
+	printer, ok := printers[opts.output]
+	if !ok {
+		fmt.Fprintf(stderr, "ticketctl %s: unknown output format %q, want table, json or yaml\n", cmd.name, opts.output)
+		return 2
+	}
+
+	conn, err := opts.dial()
+	if err != nil {
+		fmt.Fprintf(stderr, "ticketctl %s: %v\n", cmd.name, err)
+		return 1
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+	reply, err := call(ctx, pb.NewTicketServiceClient(conn))
+	if err != nil {
+		printError(stderr, cmd.name, err)
+		return 1
+	}
+	if err := printer(stdout, reply); err != nil {
+		fmt.Fprintf(stderr, "ticketctl %s: %v\n", cmd.name, err)
+		return 1
+	}
+	return 0
+}
+
+// Helper function to look up a subcommand by name
+func findCommand(name string) *command {
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: ticketctl <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-14s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Every command takes -addr, -tls, -ca-cert, -server-name, -insecure-skip-verify, -timeout and -o.")
+	fmt.Fprintln(w, "Run ticketctl <command> -h for the flags of a command.")
+}
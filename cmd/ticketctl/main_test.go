@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeService answers GetReceipt for john@example.com and refuses every
+// other user, as the server does for users without a booking
+type fakeService struct {
+	pb.UnimplementedTicketServiceServer
+}
+
+func (fakeService) GetReceipt(ctx context.Context, req *pb.ReceiptRequest) (*pb.Receipt, error) {
+	if req.Email != testReceipt.User.Email {
+		st, _ := status.New(codes.FailedPrecondition, "user has no booking").WithDetails(&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: "NO_BOOKING", Subject: req.Email, Description: "user has no booking"}},
+		})
+		return nil, st.Err()
+	}
+	return testReceipt, nil
+}
+
+func (fakeService) PurchaseTicket(ctx context.Context, req *pb.PurchaseRequest) (*pb.Receipt, error) {
+	receipt := proto.Clone(testReceipt).(*pb.Receipt)
+	receipt.Price = req.Price
+	return receipt, nil
+}
+
+// Helper function to serve fakeService on a local port for the test
+func startFakeServer(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	pb.RegisterTicketServiceServer(srv, fakeService{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestRun(t *testing.T) {
+	addr := startFakeServer(t)
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string // Expected within stdout
+		wantStderr string // Expected within stderr
+	}{
+		{name: "no command", wantCode: 2, wantStderr: "Usage: ticketctl <command> [flags]"},
+		{name: "help", args: []string{"help"}, wantCode: 0, wantStderr: "modify-seat"},
+		{name: "unknown command", args: []string{"book"}, wantCode: 2, wantStderr: `unknown command "book"`},
+		{name: "command help", args: []string{"receipt", "-h"}, wantCode: 0, wantStderr: "-email"},
+		{name: "unknown flag", args: []string{"receipt", "-seat", "A1"}, wantCode: 2, wantStderr: "flag provided but not defined: -seat"},
+		{name: "unexpected argument", args: []string{"receipt", "john@example.com"}, wantCode: 2, wantStderr: `unexpected argument "john@example.com"`},
+		{name: "unknown output format", args: []string{"receipt", "-o", "xml"}, wantCode: 2, wantStderr: `unknown output format "xml"`},
+		{
+			name:       "call",
+			args:       []string{"receipt", "-addr", addr, "-email", "john@example.com"},
+			wantCode:   0,
+			wantStdout: "ABCDEFGH  John Doe",
+		},
+		{
+			name:       "json output",
+			args:       []string{"receipt", "-addr", addr, "-email", "john@example.com", "-o", "json"},
+			wantCode:   0,
+			wantStdout: `"booking_id": "ABCDEFGH"`,
+		},
+		{
+			name:       "price",
+			args:       []string{"purchase", "-addr", addr, "-price", "20.50", "-o", "json"},
+			wantCode:   0,
+			wantStdout: `"nanos": 500000000`,
+		},
+		{
+			name:       "failed call",
+			args:       []string{"receipt", "-addr", addr, "-email", "jane@example.com"},
+			wantCode:   1,
+			wantStderr: "FailedPrecondition: user has no booking\n  NO_BOOKING jane@example.com: user has no booking",
+		},
+		{
+			name:       "negative price",
+			args:       []string{"purchase", "-addr", addr, "-price", "-0.50"},
+			wantCode:   1,
+			wantStderr: `invalid price "-0.50"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			code := run(tt.args, &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantStdout) {
+				t.Errorf("stdout = %q, want it to contain %q", stdout.String(), tt.wantStdout)
+			}
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+// printers write a reply in each of the -o formats
+var printers = map[string]func(io.Writer, proto.Message) error{
+	"table": printTable,
+	"json":  printJSON,
+	"yaml":  printYAML,
+}
+
+// printTable writes the reply as a table for reading in a terminal
+func printTable(w io.Writer, reply proto.Message) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	switch reply := reply.(type) {
+	case *pb.Receipt:
+		fmt.Fprintln(tw, "BOOKING\tPASSENGER\tEMAIL\tDEPARTURE\tFROM\tTO\tSEAT\tCLASS\tPRICE\tSTATUS")
+		fmt.Fprintf(tw, "%s\t%s %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			reply.BookingId, reply.User.GetFirstName(), reply.User.GetLastName(), reply.User.GetEmail(),
+			reply.DepartureId, reply.From, reply.To, orDash(reply.Seat), orDash(reply.TravelClass),
+			formatMoney(reply.Price), enumName(reply.Status.String(), "BOOKING_STATUS_"))
+		if reply.HoldExpiresAt != nil {
+			fmt.Fprintf(tw, "\nHeld until %s; confirm the hold to keep the seat.\n", formatTime(reply.HoldExpiresAt))
+		}
+	case *pb.UserList:
+		fmt.Fprintln(tw, "SEAT\tPASSENGER\tEMAIL\tFROM\tTO")
+		for _, info := range reply.UserSeats {
+			fmt.Fprintf(tw, "%s\t%s %s\t%s\t%s\t%s\n", info.Seat,
+				info.User.GetFirstName(), info.User.GetLastName(), info.User.GetEmail(), info.From, info.To)
+		}
+	case *pb.Response:
+		fmt.Fprintln(tw, reply.Message)
+		if reply.Refund != nil {
+			fmt.Fprintf(tw, "Refund:\t%s\n", formatMoney(reply.Refund))
+		}
+	default:
+		return printJSON(w, reply)
+	}
+	return tw.Flush()
+}
+
+// printJSON writes the reply as indented JSON with the field names of the
+// proto definitions, as in request_response.txt
+func printJSON(w io.Writer, reply proto.Message) error {
+	data, err := marshalJSON(reply)
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return err
+	}
+	out.WriteByte('\n')
+	_, err = out.WriteTo(w)
+	return err
+}
+
+// printYAML writes the reply as YAML, with the fields in the same order and
+// the same values as printJSON
+func printYAML(w io.Writer, reply proto.Message) error {
+	data, err := marshalJSON(reply)
+	if err != nil {
+		return err
+	}
+	// JSON is YAML, so decoding it keeps the field order; clearing the flow
+	// style of the JSON then writes it out in block style
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// Helper function to marshal a reply with the field names of the proto
+// definitions. protojson varies its spacing between runs, so the result is
+// compact JSON for the printers to lay out.
+func marshalJSON(reply proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(reply)
+	if err != nil {
+		return nil, err
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return nil, err
+	}
+	return compact.Bytes(), nil
+}
+
+// Helper function to switch a decoded JSON document to block style
+func blockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle | yaml.DoubleQuotedStyle
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// printError writes a failed call with the details the server attached,
+// such as the fields that failed validation
+func printError(w io.Writer, name string, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		fmt.Fprintf(w, "ticketctl %s: %v\n", name, err)
+		return
+	}
+	fmt.Fprintf(w, "ticketctl %s: %s: %s\n", name, st.Code(), st.Message())
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range detail.FieldViolations {
+				fmt.Fprintf(w, "  %s: %s\n", violation.Field, violation.Description)
+			}
+		case *errdetails.PreconditionFailure:
+			for _, violation := range detail.Violations {
+				fmt.Fprintf(w, "  %s %s: %s\n", violation.Type, violation.Subject, violation.Description)
+			}
+		}
+	}
+}
+
+// Helper function to format an amount as in the server's messages, e.g. GBP 120.00
+func formatMoney(m *pb.Money) string {
+	if m == nil {
+		return "-"
+	}
+	cents := m.Units*100 + int64(m.Nanos)/10_000_000
+	return fmt.Sprintf("%s %d.%02d", m.CurrencyCode, cents/100, cents%100)
+}
+
+// Helper function to format a timestamp in local time
+func formatTime(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Local().Format(time.DateTime)
+}
+
+// Helper function to shorten an enum value for a table, e.g.
+// BOOKING_STATUS_CONFIRMED to confirmed
+func enumName(value, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(value, prefix))
+}
+
+// Helper function to show an empty table cell as a dash
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// testReceipt is a confirmed booking as the server returns it
+var testReceipt = &pb.Receipt{
+	BookingId:   "ABCDEFGH",
+	User:        &pb.User{FirstName: "John", LastName: "Doe", Email: "john@example.com"},
+	DepartureId: "LON-PAR",
+	From:        "London",
+	To:          "France",
+	Seat:        "A1",
+	TravelClass: "standard",
+	Price:       &pb.Money{CurrencyCode: "GBP", Units: 20, Nanos: 500_000_000},
+	Status:      pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+}
+
+func TestPrinters(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		reply  proto.Message
+		want   string
+	}{
+		{
+			name:   "receipt table",
+			format: "table",
+			reply:  testReceipt,
+			want: "BOOKING   PASSENGER  EMAIL             DEPARTURE  FROM    TO      SEAT  CLASS     PRICE      STATUS\n" +
+				"ABCDEFGH  John Doe   john@example.com  LON-PAR    London  France  A1    standard  GBP 20.50  confirmed\n",
+		},
+		{
+			name:   "user list table",
+			format: "table",
+			reply: &pb.UserList{UserSeats: []*pb.UserSeatInfo{
+				{User: testReceipt.User, Seat: "A1", From: "London", To: "France"},
+			}},
+			want: "SEAT  PASSENGER  EMAIL             FROM    TO\n" +
+				"A1    John Doe   john@example.com  London  France\n",
+		},
+		{
+			name:   "response table",
+			format: "table",
+			reply:  &pb.Response{Message: "User removed", Refund: &pb.Money{CurrencyCode: "GBP", Units: 10}},
+			want:   "User removed\nRefund:  GBP 10.00\n",
+		},
+		{
+			name:   "json",
+			format: "json",
+			reply:  &pb.Response{Message: "User removed", Refund: &pb.Money{CurrencyCode: "GBP", Units: 10}},
+			want: `{
+  "message": "User removed",
+  "refund": {
+    "currency_code": "GBP",
+    "units": "10"
+  }
+}
+`,
+		},
+		{
+			name:   "yaml",
+			format: "yaml",
+			reply:  &pb.Response{Message: "User removed", Refund: &pb.Money{CurrencyCode: "GBP", Units: 10}},
+			want: `message: User removed
+refund:
+  currency_code: GBP
+  units: "10"
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := printers[tt.format](&out, tt.reply); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("output =\n%s\nwant\n%s", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPrintError(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid PurchaseRequest").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "user.email", Description: "must be a valid email address"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "field violations",
+			err:  st.Err(),
+			want: "ticketctl purchase: InvalidArgument: invalid PurchaseRequest\n  user.email: must be a valid email address\n",
+		},
+		{
+			name: "not a status",
+			err:  errors.New(`invalid price "-1"`),
+			want: "ticketctl purchase: invalid price \"-1\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			printError(&out, "purchase", tt.err)
+			if out.String() != tt.want {
+				t.Errorf("printError() wrote %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+	pbv2 "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v2"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// connectV1 and connectV2 serve the two versions of TicketService over the
+// Connect and gRPC-Web protocols, so browsers can use the generated stubs.
+// Like the HTTP gateway they forward every call to the gRPC server, which
+// validates it and reports its errors as for any gRPC client.
+type connectV1 struct {
+	client pb.TicketServiceClient
+}
+
+type connectV2 struct {
+	client pbv2.TicketServiceClient
+}
+
+// Helper function to forward a unary call to the gRPC server
+func forwardUnary[Req, Res any](ctx context.Context, req *connect.Request[Req], call func(context.Context, *Req, ...grpc.CallOption) (*Res, error)) (*connect.Response[Res], error) {
+	resp, err := call(ctx, req.Msg)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// Helper function to forward a server-streaming call to the gRPC server,
+// relaying its messages until it ends the stream
+func forwardStream[Req, Res any](ctx context.Context, req *connect.Request[Req], stream *connect.ServerStream[Res], call func(context.Context, *Req, ...grpc.CallOption) (grpc.ServerStreamingClient[Res], error)) error {
+	upstream, err := call(ctx, req.Msg)
+	if err != nil {
+		return connectError(err)
+	}
+	for {
+		msg, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return connectError(err)
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// Helper function to convert a gRPC status error into a Connect error with
+// the same code, message and details
+func connectError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Proto().GetDetails() {
+		if detail, err := connect.NewErrorDetail(d); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
+
+func (c *connectV1) PurchaseTicket(ctx context.Context, req *connect.Request[pb.PurchaseRequest]) (*connect.Response[pb.Receipt], error) {
+	return forwardUnary(ctx, req, c.client.PurchaseTicket)
+}
+
+func (c *connectV1) GetReceipt(ctx context.Context, req *connect.Request[pb.ReceiptRequest]) (*connect.Response[pb.Receipt], error) {
+	return forwardUnary(ctx, req, c.client.GetReceipt)
+}
+
+func (c *connectV1) GetAllocatedUsers(ctx context.Context, req *connect.Request[pb.SectionRequest]) (*connect.Response[pb.UserList], error) {
+	return forwardUnary(ctx, req, c.client.GetAllocatedUsers)
+}
+
+func (c *connectV1) RemoveUser(ctx context.Context, req *connect.Request[pb.RemoveRequest]) (*connect.Response[pb.Response], error) {
+	return forwardUnary(ctx, req, c.client.RemoveUser)
+}
+
+func (c *connectV1) ModifySeat(ctx context.Context, req *connect.Request[pb.ModifyRequest]) (*connect.Response[pb.Response], error) {
+	return forwardUnary(ctx, req, c.client.ModifySeat)
+}
+
+func (c *connectV1) ListRoutes(ctx context.Context, req *connect.Request[pb.RoutesRequest]) (*connect.Response[pb.RouteList], error) {
+	return forwardUnary(ctx, req, c.client.ListRoutes)
+}
+
+func (c *connectV1) ListDepartures(ctx context.Context, req *connect.Request[pb.DeparturesRequest]) (*connect.Response[pb.DepartureList], error) {
+	return forwardUnary(ctx, req, c.client.ListDepartures)
+}
+
+func (c *connectV1) GetBooking(ctx context.Context, req *connect.Request[pb.BookingRequest]) (*connect.Response[pb.Receipt], error) {
+	return forwardUnary(ctx, req, c.client.GetBooking)
+}
+
+func (c *connectV1) CancelBooking(ctx context.Context, req *connect.Request[pb.BookingRequest]) (*connect.Response[pb.Response], error) {
+	return forwardUnary(ctx, req, c.client.CancelBooking)
+}
+
+func (c *connectV1) ModifyBooking(ctx context.Context, req *connect.Request[pb.ModifyBookingRequest]) (*connect.Response[pb.Response], error) {
+	return forwardUnary(ctx, req, c.client.ModifyBooking)
+}
+
+func (c *connectV1) ListMyBookings(ctx context.Context, req *connect.Request[pb.MyBookingsRequest]) (*connect.Response[pb.ReceiptList], error) {
+	return forwardUnary(ctx, req, c.client.ListMyBookings)
+}
+
+func (c *connectV1) GetQuote(ctx context.Context, req *connect.Request[pb.QuoteRequest]) (*connect.Response[pb.QuoteList], error) {
+	return forwardUnary(ctx, req, c.client.GetQuote)
+}
+
+func (c *connectV1) HoldSeat(ctx context.Context, req *connect.Request[pb.HoldRequest]) (*connect.Response[pb.Receipt], error) {
+	return forwardUnary(ctx, req, c.client.HoldSeat)
+}
+
+func (c *connectV1) ConfirmHold(ctx context.Context, req *connect.Request[pb.ConfirmHoldRequest]) (*connect.Response[pb.Receipt], error) {
+	return forwardUnary(ctx, req, c.client.ConfirmHold)
+}
+
+func (c *connectV1) PurchaseGroup(ctx context.Context, req *connect.Request[pb.GroupPurchaseRequest]) (*connect.Response[pb.GroupReceipt], error) {
+	return forwardUnary(ctx, req, c.client.PurchaseGroup)
+}
+
+func (c *connectV1) GetGroup(ctx context.Context, req *connect.Request[pb.GroupRequest]) (*connect.Response[pb.GroupReceipt], error) {
+	return forwardUnary(ctx, req, c.client.GetGroup)
+}
+
+func (c *connectV1) CancelGroup(ctx context.Context, req *connect.Request[pb.GroupRequest]) (*connect.Response[pb.Response], error) {
+	return forwardUnary(ctx, req, c.client.CancelGroup)
+}
+
+func (c *connectV1) GetSeatMap(ctx context.Context, req *connect.Request[pb.SeatMapRequest]) (*connect.Response[pb.SeatMap], error) {
+	return forwardUnary(ctx, req, c.client.GetSeatMap)
+}
+
+func (c *connectV1) WatchSeats(ctx context.Context, req *connect.Request[pb.WatchSeatsRequest], stream *connect.ServerStream[pb.SeatEvent]) error {
+	return forwardStream(ctx, req, stream, c.client.WatchSeats)
+}
+
+func (c *connectV1) SwapSeats(ctx context.Context, req *connect.Request[pb.SwapRequest]) (*connect.Response[pb.SwapResponse], error) {
+	return forwardUnary(ctx, req, c.client.SwapSeats)
+}
+
+func (c *connectV1) ChangeSeat(ctx context.Context, req *connect.Request[pb.ChangeSeatRequest]) (*connect.Response[pb.SeatChange], error) {
+	return forwardUnary(ctx, req, c.client.ChangeSeat)
+}
+
+func (c *connectV2) ListRoutes(ctx context.Context, req *connect.Request[pbv2.ListRoutesRequest]) (*connect.Response[pbv2.ListRoutesResponse], error) {
+	return forwardUnary(ctx, req, c.client.ListRoutes)
+}
+
+func (c *connectV2) ListDepartures(ctx context.Context, req *connect.Request[pbv2.ListDeparturesRequest]) (*connect.Response[pbv2.ListDeparturesResponse], error) {
+	return forwardUnary(ctx, req, c.client.ListDepartures)
+}
+
+func (c *connectV2) GetQuote(ctx context.Context, req *connect.Request[pbv2.GetQuoteRequest]) (*connect.Response[pbv2.GetQuoteResponse], error) {
+	return forwardUnary(ctx, req, c.client.GetQuote)
+}
+
+func (c *connectV2) GetSeatMap(ctx context.Context, req *connect.Request[pbv2.GetSeatMapRequest]) (*connect.Response[pbv2.GetSeatMapResponse], error) {
+	return forwardUnary(ctx, req, c.client.GetSeatMap)
+}
+
+func (c *connectV2) WatchSeats(ctx context.Context, req *connect.Request[pbv2.WatchSeatsRequest], stream *connect.ServerStream[pbv2.WatchSeatsResponse]) error {
+	return forwardStream(ctx, req, stream, c.client.WatchSeats)
+}
+
+func (c *connectV2) CreateBooking(ctx context.Context, req *connect.Request[pbv2.CreateBookingRequest]) (*connect.Response[pbv2.CreateBookingResponse], error) {
+	return forwardUnary(ctx, req, c.client.CreateBooking)
+}
+
+func (c *connectV2) HoldSeat(ctx context.Context, req *connect.Request[pbv2.HoldSeatRequest]) (*connect.Response[pbv2.HoldSeatResponse], error) {
+	return forwardUnary(ctx, req, c.client.HoldSeat)
+}
+
+func (c *connectV2) ConfirmHold(ctx context.Context, req *connect.Request[pbv2.ConfirmHoldRequest]) (*connect.Response[pbv2.ConfirmHoldResponse], error) {
+	return forwardUnary(ctx, req, c.client.ConfirmHold)
+}
+
+func (c *connectV2) GetBooking(ctx context.Context, req *connect.Request[pbv2.GetBookingRequest]) (*connect.Response[pbv2.GetBookingResponse], error) {
+	return forwardUnary(ctx, req, c.client.GetBooking)
+}
+
+func (c *connectV2) ListBookings(ctx context.Context, req *connect.Request[pbv2.ListBookingsRequest]) (*connect.Response[pbv2.ListBookingsResponse], error) {
+	return forwardUnary(ctx, req, c.client.ListBookings)
+}
+
+func (c *connectV2) CancelBooking(ctx context.Context, req *connect.Request[pbv2.CancelBookingRequest]) (*connect.Response[pbv2.CancelBookingResponse], error) {
+	return forwardUnary(ctx, req, c.client.CancelBooking)
+}
+
+func (c *connectV2) ChangeSeat(ctx context.Context, req *connect.Request[pbv2.ChangeSeatRequest]) (*connect.Response[pbv2.ChangeSeatResponse], error) {
+	return forwardUnary(ctx, req, c.client.ChangeSeat)
+}
+
+func (c *connectV2) SwapSeats(ctx context.Context, req *connect.Request[pbv2.SwapSeatsRequest]) (*connect.Response[pbv2.SwapSeatsResponse], error) {
+	return forwardUnary(ctx, req, c.client.SwapSeats)
+}
+
+func (c *connectV2) CreateGroupBooking(ctx context.Context, req *connect.Request[pbv2.CreateGroupBookingRequest]) (*connect.Response[pbv2.CreateGroupBookingResponse], error) {
+	return forwardUnary(ctx, req, c.client.CreateGroupBooking)
+}
+
+func (c *connectV2) GetGroupBooking(ctx context.Context, req *connect.Request[pbv2.GetGroupBookingRequest]) (*connect.Response[pbv2.GetGroupBookingResponse], error) {
+	return forwardUnary(ctx, req, c.client.GetGroupBooking)
+}
+
+func (c *connectV2) CancelGroupBooking(ctx context.Context, req *connect.Request[pbv2.CancelGroupBookingRequest]) (*connect.Response[pbv2.CancelGroupBookingResponse], error) {
+	return forwardUnary(ctx, req, c.client.CancelGroupBooking)
+}
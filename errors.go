@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Resource types reported in ResourceInfo error details
+const (
+	resourceDeparture = "departure"
+	resourceBooking   = "booking"
+	resourceReceipt   = "receipt"
+	resourceSeat      = "seat"
+	resourceGroup     = "group"
+)
+
+// Precondition types reported in PreconditionFailure error details
+const (
+	preconditionAmbiguousBooking = "AMBIGUOUS_BOOKING"
+	preconditionSameSeat         = "SAME_SEAT"
+	preconditionPriceMismatch    = "PRICE_MISMATCH"
+	preconditionNotHeld          = "NOT_HELD"
+	preconditionHoldExpired      = "HOLD_EXPIRED"
+	preconditionPaymentDeclined  = "PAYMENT_DECLINED"
+	preconditionCancelled        = "CANCELLED"
+	preconditionWaitlisted       = "WAITLISTED"
+	preconditionCursorExpired    = "CURSOR_EXPIRED"
+	preconditionNotConfirmed     = "NOT_CONFIRMED"
+	preconditionClassMismatch    = "CLASS_MISMATCH"
+	preconditionFareDifference   = "FARE_DIFFERENCE"
+	preconditionNotRefundable    = "NOT_REFUNDABLE"
+)
+
+// statusWithDetails builds a gRPC status error carrying details. If the
+// details cannot be attached the bare status is returned.
+func statusWithDetails(code codes.Code, message string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// notFoundError reports that the named resource does not exist
+func notFoundError(resourceType, name, message string) error {
+	return statusWithDetails(codes.NotFound, message, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  message,
+	})
+}
+
+// alreadyExistsError reports that the named resource is already claimed
+func alreadyExistsError(resourceType, name, message string) error {
+	return statusWithDetails(codes.AlreadyExists, message, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  message,
+	})
+}
+
+// exhaustedError reports that the named resource has no capacity left
+func exhaustedError(resourceType, name, message string) error {
+	return statusWithDetails(codes.ResourceExhausted, message, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  message,
+	})
+}
+
+// permissionDeniedError reports that the caller may not act on the named resource
+func permissionDeniedError(resourceType, name, message string) error {
+	return statusWithDetails(codes.PermissionDenied, message, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  message,
+	})
+}
+
+// invalidArgumentError reports a malformed request field
+func invalidArgumentError(field, message string) error {
+	return statusWithDetails(codes.InvalidArgument, message, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: message},
+		},
+	})
+}
+
+// preconditionError reports that the system is not in a state the request can be applied to
+func preconditionError(violationType, subject, message string) error {
+	return statusWithDetails(codes.FailedPrecondition, message, &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: message},
+		},
+	})
+}
+
+// errorInterceptor makes sure every error leaving a handler carries a
+// canonical code: context errors keep their meaning and anything else that
+// is not already a status (storage failures, mostly) becomes Internal
+func errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+// streamErrorInterceptor is errorInterceptor for streaming RPCs
+func streamErrorInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatusError(handler(srv, ss))
+}
+
+// toStatusError converts err to a gRPC status error
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
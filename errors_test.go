@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "nil", err: nil, wantCode: codes.OK},
+		{name: "status", err: notFoundError(resourceBooking, "X", "booking not found"), wantCode: codes.NotFound},
+		{name: "canceled", err: context.Canceled, wantCode: codes.Canceled},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, wantCode: codes.DeadlineExceeded},
+		{name: "wrapped context error", err: fmt.Errorf("read receipts: %w", context.DeadlineExceeded), wantCode: codes.DeadlineExceeded},
+		{name: "storage failure", err: errors.New("disk full"), wantCode: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := toStatusError(tt.err)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("toStatusError(%v) code = %s, want %s", tt.err, got, tt.wantCode)
+			}
+			if tt.err != nil && tt.wantCode != codes.Canceled && tt.wantCode != codes.DeadlineExceeded {
+				if got := status.Convert(err).Message(); got != status.Convert(tt.err).Message() {
+					t.Errorf("message = %q, want %q", got, status.Convert(tt.err).Message())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+)
+
+// Fare prices journeys on a route. The most specific fare matching a seat
+// wins: one naming its section, then one naming its travel class, then one
+// for the whole route.
+type Fare struct {
+	Route   string `json:"route"`
+	Class   string `json:"class,omitempty"`
+	Section string `json:"section,omitempty"`
+	Base    Amount `json:"base"`    // Charged once per ticket
+	PerLeg  Amount `json:"per_leg"` // Charged for each leg travelled
+}
+
+// fare returns the fare for travelling on route in section
+func (c *Catalogue) fare(route string, section *SectionLayout) (*Fare, bool) {
+	var byClass, byRoute *Fare
+	for _, fare := range c.Fares {
+		if fare.Route != route {
+			continue
+		}
+		switch {
+		case fare.Section != "":
+			if fare.Section == section.Name {
+				return fare, true
+			}
+		case fare.Class != "":
+			if fare.Class == section.Class && byClass == nil {
+				byClass = fare
+			}
+		default:
+			if byRoute == nil {
+				byRoute = fare
+			}
+		}
+	}
+	if byClass != nil {
+		return byClass, true
+	}
+	return byRoute, byRoute != nil
+}
+
+// Price returns the price of a journey on departure in section, in the
+// catalogue's currency and rounded to the cent
+func (c *Catalogue) Price(departure *Departure, section *SectionLayout, journey legs) (Money, error) {
+	fare, ok := c.fare(departure.Route, section)
+	if !ok {
+		return Money{}, fmt.Errorf("no fare for section %s on route %s", section.Name, departure.Route)
+	}
+	price := fare.Base + fare.PerLeg*Amount(journey.to-journey.from)
+	return Money{Currency: c.Currency, Amount: price.RoundToCent()}, nil
+}
+
+// checkPrice compares the price a client agreed to pay, if any, with the fare
+func checkPrice(offered *Money, fare Money) error {
+	if offered != nil && *offered != fare {
+		return preconditionError(preconditionPriceMismatch, "price",
+			fmt.Sprintf("price %s does not match the fare of %s", *offered, fare))
+	}
+	return nil
+}
+
+// quote is the price of a journey in one section, with the seats left there
+type quote struct {
+	section   *SectionLayout
+	fare      Money
+	available int
+}
+
+// quoteList prices a journey on a departure
+type quoteList struct {
+	departure *Departure
+	from, to  string
+	quotes    []quote
+}
+
+// Helper function to price a journey in every section of the departure
+// (or the requested section or travel class), with the seats still
+// available in each
+func (s *server) quote(departureID, from, to, travelClass, sectionName string) (*quoteList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	departure, inv, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, journey, err := resolveJourney(departure, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if sectionName != "" {
+		if _, ok := inv.layout.Section(sectionName); !ok {
+			return nil, invalidArgumentError("section", "invalid section")
+		}
+	}
+	if travelClass != "" && !inv.layout.HasClass(travelClass) {
+		return nil, invalidArgumentError("travel_class", "no section of the departure has this travel class")
+	}
+
+	list := &quoteList{departure: departure, from: from, to: to}
+	for _, section := range inv.layout.Sections {
+		if (sectionName != "" && section.Name != sectionName) || (travelClass != "" && section.Class != travelClass) {
+			continue
+		}
+		fare, err := s.catalogue.Price(departure, section, journey)
+		if err != nil {
+			return nil, err
+		}
+		list.quotes = append(list.quotes, quote{section: section, fare: fare, available: inv.availableIn(section, journey)})
+	}
+	return list, nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+	"github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1/ticketv1connect"
+	pbv2 "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v2"
+	"github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v2/ticketv2connect"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/cors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// openAPIDocument describes the HTTP API served by the gateway. It is
+// generated from the HTTP bindings in proto/ticket/v1/ticket.proto.
+//
+//go:embed gen/openapi/ticket/v1/ticket.swagger.json
+var openAPIDocument []byte
+
+// newHTTPHandler returns the handler of the HTTP port: the JSON gateway, the
+// Connect and gRPC-Web protocols for both versions of TicketService, and the
+// OpenAPI document. Every call is forwarded to the gRPC server over conn.
+// Browser pages from allowedOrigins may call it cross-origin; with none,
+// only same-origin pages can.
+func newHTTPHandler(ctx context.Context, conn *grpc.ClientConn, allowedOrigins []string) (http.Handler, error) {
+	gateway, err := newGateway(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gateway)
+	mux.Handle(ticketv1connect.NewTicketServiceHandler(&connectV1{client: pb.NewTicketServiceClient(conn)}))
+	mux.Handle(ticketv2connect.NewTicketServiceHandler(&connectV2{client: pbv2.NewTicketServiceClient(conn)}))
+
+	if len(allowedOrigins) == 0 {
+		return mux, nil
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
+		// The request headers of the Connect and gRPC-Web protocols
+		AllowedHeaders: []string{"Content-Type", "Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout", "X-Grpc-Web", "X-User-Agent"},
+		// Browsers hide response headers from scripts unless exposed; gRPC-Web
+		// clients read the status from these
+		ExposedHeaders: []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"},
+		MaxAge:         int((2 * time.Hour).Seconds()),
+	}).Handler(mux), nil
+}
+
+// Helper function to build the JSON gateway for the RPCs that have an HTTP
+// binding, using the field names of the proto definitions as in
+// request_response.txt. The OpenAPI document is served at /openapi.json.
+func newGateway(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	if err := pb.RegisterTicketServiceHandler(ctx, mux, conn); err != nil {
+		return nil, err
+	}
+
+	err := mux.HandlePath(http.MethodGet, "/openapi.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPIDocument)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// Helper function to split a comma-separated flag value, dropping blanks
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
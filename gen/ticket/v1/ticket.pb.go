@@ -0,0 +1,3873 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: ticket/v1/ticket.proto
+
+package ticketv1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SeatPosition int32
+
+const (
+	SeatPosition_SEAT_POSITION_ANY    SeatPosition = 0
+	SeatPosition_SEAT_POSITION_WINDOW SeatPosition = 1 // First or last seat of a row
+	SeatPosition_SEAT_POSITION_AISLE  SeatPosition = 2 // Next to the aisle down the middle of the row
+)
+
+// Enum value maps for SeatPosition.
+var (
+	SeatPosition_name = map[int32]string{
+		0: "SEAT_POSITION_ANY",
+		1: "SEAT_POSITION_WINDOW",
+		2: "SEAT_POSITION_AISLE",
+	}
+	SeatPosition_value = map[string]int32{
+		"SEAT_POSITION_ANY":    0,
+		"SEAT_POSITION_WINDOW": 1,
+		"SEAT_POSITION_AISLE":  2,
+	}
+)
+
+func (x SeatPosition) Enum() *SeatPosition {
+	p := new(SeatPosition)
+	*p = x
+	return p
+}
+
+func (x SeatPosition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatPosition) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v1_ticket_proto_enumTypes[0].Descriptor()
+}
+
+func (SeatPosition) Type() protoreflect.EnumType {
+	return &file_ticket_v1_ticket_proto_enumTypes[0]
+}
+
+func (x SeatPosition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatPosition.Descriptor instead.
+func (SeatPosition) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{0}
+}
+
+type BookingStatus int32
+
+const (
+	BookingStatus_BOOKING_STATUS_CONFIRMED  BookingStatus = 0 // Zero so receipts from before holds read as confirmed
+	BookingStatus_BOOKING_STATUS_HELD       BookingStatus = 1 // Seat reserved until hold_expires_at, awaiting ConfirmHold
+	BookingStatus_BOOKING_STATUS_CANCELLED  BookingStatus = 2 // Kept for the booking history; the seat is free again
+	BookingStatus_BOOKING_STATUS_WAITLISTED BookingStatus = 3 // No seat yet; held for the user when one frees up
+)
+
+// Enum value maps for BookingStatus.
+var (
+	BookingStatus_name = map[int32]string{
+		0: "BOOKING_STATUS_CONFIRMED",
+		1: "BOOKING_STATUS_HELD",
+		2: "BOOKING_STATUS_CANCELLED",
+		3: "BOOKING_STATUS_WAITLISTED",
+	}
+	BookingStatus_value = map[string]int32{
+		"BOOKING_STATUS_CONFIRMED":  0,
+		"BOOKING_STATUS_HELD":       1,
+		"BOOKING_STATUS_CANCELLED":  2,
+		"BOOKING_STATUS_WAITLISTED": 3,
+	}
+)
+
+func (x BookingStatus) Enum() *BookingStatus {
+	p := new(BookingStatus)
+	*p = x
+	return p
+}
+
+func (x BookingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v1_ticket_proto_enumTypes[1].Descriptor()
+}
+
+func (BookingStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v1_ticket_proto_enumTypes[1]
+}
+
+func (x BookingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingStatus.Descriptor instead.
+func (BookingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{1}
+}
+
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED        PaymentStatus = 0 // Not paid yet (held) or booked before payments were taken
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED         PaymentStatus = 1 // Amount reserved, not yet collected
+	PaymentStatus_PAYMENT_STATUS_CAPTURED           PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_REFUNDED           PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED PaymentStatus = 4
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_AUTHORIZED",
+		2: "PAYMENT_STATUS_CAPTURED",
+		3: "PAYMENT_STATUS_REFUNDED",
+		4: "PAYMENT_STATUS_PARTIALLY_REFUNDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED":        0,
+		"PAYMENT_STATUS_AUTHORIZED":         1,
+		"PAYMENT_STATUS_CAPTURED":           2,
+		"PAYMENT_STATUS_REFUNDED":           3,
+		"PAYMENT_STATUS_PARTIALLY_REFUNDED": 4,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v1_ticket_proto_enumTypes[2].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v1_ticket_proto_enumTypes[2]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{2}
+}
+
+type SeatStatus int32
+
+const (
+	SeatStatus_SEAT_STATUS_FREE    SeatStatus = 0
+	SeatStatus_SEAT_STATUS_HELD    SeatStatus = 1 // Held for a customer, may become free again
+	SeatStatus_SEAT_STATUS_BOOKED  SeatStatus = 2
+	SeatStatus_SEAT_STATUS_BLOCKED SeatStatus = 3 // Not on sale
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "SEAT_STATUS_FREE",
+		1: "SEAT_STATUS_HELD",
+		2: "SEAT_STATUS_BOOKED",
+		3: "SEAT_STATUS_BLOCKED",
+	}
+	SeatStatus_value = map[string]int32{
+		"SEAT_STATUS_FREE":    0,
+		"SEAT_STATUS_HELD":    1,
+		"SEAT_STATUS_BOOKED":  2,
+		"SEAT_STATUS_BLOCKED": 3,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v1_ticket_proto_enumTypes[3].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v1_ticket_proto_enumTypes[3]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{3}
+}
+
+type SwapStatus int32
+
+const (
+	SwapStatus_SWAP_STATUS_OFFERED SwapStatus = 0 // Waiting for the other holder to agree
+	SwapStatus_SWAP_STATUS_SWAPPED SwapStatus = 1
+)
+
+// Enum value maps for SwapStatus.
+var (
+	SwapStatus_name = map[int32]string{
+		0: "SWAP_STATUS_OFFERED",
+		1: "SWAP_STATUS_SWAPPED",
+	}
+	SwapStatus_value = map[string]int32{
+		"SWAP_STATUS_OFFERED": 0,
+		"SWAP_STATUS_SWAPPED": 1,
+	}
+)
+
+func (x SwapStatus) Enum() *SwapStatus {
+	p := new(SwapStatus)
+	*p = x
+	return p
+}
+
+func (x SwapStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v1_ticket_proto_enumTypes[4].Descriptor()
+}
+
+func (SwapStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v1_ticket_proto_enumTypes[4]
+}
+
+func (x SwapStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapStatus.Descriptor instead.
+func (SwapStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{4}
+}
+
+// Messages
+type PurchaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	User *User  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Deprecated: Marked as deprecated in ticket/v1/ticket.proto.
+	PricePaid    float32         `protobuf:"fixed32,4,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`     // Legacy: use price
+	DepartureId  string          `protobuf:"bytes,5,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"` // Defaults to the catalogue's default departure
+	TravelClass  string          `protobuf:"bytes,6,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"` // e.g. "standard" or "first"; any class when empty
+	Price        *Money          `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`                                // Optional; when set it must match the fare
+	Payment      *PaymentMethod  `protobuf:"bytes,8,opt,name=payment,proto3" json:"payment,omitempty"`
+	JoinWaitlist bool            `protobuf:"varint,9,opt,name=join_waitlist,json=joinWaitlist,proto3" json:"join_waitlist,omitempty"` // Queue for a seat instead of failing when the train is full
+	Preference   *SeatPreference `protobuf:"bytes,10,opt,name=preference,proto3" json:"preference,omitempty"`
+}
+
+func (x *PurchaseRequest) Reset() {
+	*x = PurchaseRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseRequest) ProtoMessage() {}
+
+func (x *PurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PurchaseRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PurchaseRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PurchaseRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in ticket/v1/ticket.proto.
+func (x *PurchaseRequest) GetPricePaid() float32 {
+	if x != nil {
+		return x.PricePaid
+	}
+	return 0
+}
+
+func (x *PurchaseRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *PurchaseRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *PurchaseRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PurchaseRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PurchaseRequest) GetJoinWaitlist() bool {
+	if x != nil {
+		return x.JoinWaitlist
+	}
+	return false
+}
+
+func (x *PurchaseRequest) GetPreference() *SeatPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+// Where a passenger would like to sit. section and seat must be met; the
+// others are honoured when a matching seat is free.
+type SeatPreference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section       string       `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Seat          string       `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	Position      SeatPosition `protobuf:"varint,3,opt,name=position,proto3,enum=ticket.v1.SeatPosition" json:"position,omitempty"`
+	NearBookingId string       `protobuf:"bytes,4,opt,name=near_booking_id,json=nearBookingId,proto3" json:"near_booking_id,omitempty"` // Sit as close as possible to this booking
+}
+
+func (x *SeatPreference) Reset() {
+	*x = SeatPreference{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatPreference) ProtoMessage() {}
+
+func (x *SeatPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatPreference.ProtoReflect.Descriptor instead.
+func (*SeatPreference) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeatPreference) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SeatPreference) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *SeatPreference) GetPosition() SeatPosition {
+	if x != nil {
+		return x.Position
+	}
+	return SeatPosition_SEAT_POSITION_ANY
+}
+
+func (x *SeatPreference) GetNearBookingId() string {
+	if x != nil {
+		return x.NearBookingId
+	}
+	return ""
+}
+
+// Payment method issued by the payment provider, e.g. a tokenised card
+type PaymentMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PaymentMethod) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	User *User  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Deprecated: Marked as deprecated in ticket/v1/ticket.proto.
+	PricePaid       float32                `protobuf:"fixed32,4,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"` // Legacy: approximation of price
+	Seat            string                 `protobuf:"bytes,5,opt,name=seat,proto3" json:"seat,omitempty"`
+	DepartureId     string                 `protobuf:"bytes,6,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	DepartsAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=departs_at,json=departsAt,proto3" json:"departs_at,omitempty"`
+	BookingId       string                 `protobuf:"bytes,8,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"` // Unique booking reference
+	TravelClass     string                 `protobuf:"bytes,9,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Price           *Money                 `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	Status          BookingStatus          `protobuf:"varint,11,opt,name=status,proto3,enum=ticket.v1.BookingStatus" json:"status,omitempty"`
+	HoldExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // Set while the booking is held
+	PaymentStatus   PaymentStatus          `protobuf:"varint,13,opt,name=payment_status,json=paymentStatus,proto3,enum=ticket.v1.PaymentStatus" json:"payment_status,omitempty"`
+	TransactionId   string                 `protobuf:"bytes,14,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`       // Payment reference at the payment provider
+	CancelledAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`             // Set once the booking is cancelled
+	Refund          *Money                 `protobuf:"bytes,16,opt,name=refund,proto3" json:"refund,omitempty"`                                          // Amount paid back on cancellation
+	WaitlistedAt    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=waitlisted_at,json=waitlistedAt,proto3" json:"waitlisted_at,omitempty"`          // Set when the booking joined the waitlist
+	GroupId         string                 `protobuf:"bytes,18,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                         // Reference of the group purchase the booking is part of
+	Preference      *SeatPreference        `protobuf:"bytes,19,opt,name=preference,proto3" json:"preference,omitempty"`                                  // Where a waitlisted booking wants to sit; honoured when a seat is allocated to it
+	SwapWith        string                 `protobuf:"bytes,20,opt,name=swap_with,json=swapWith,proto3" json:"swap_with,omitempty"`                      // Booking the holder has offered to swap seats with
+	SwapSeat        string                 `protobuf:"bytes,21,opt,name=swap_seat,json=swapSeat,proto3" json:"swap_seat,omitempty"`                      // Seat of swap_with at the time of the offer
+	FareAdjustments []*FareAdjustment      `protobuf:"bytes,22,rep,name=fare_adjustments,json=fareAdjustments,proto3" json:"fare_adjustments,omitempty"` // Fare differences paid on changes to dearer seats
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Receipt) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Receipt) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Receipt) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in ticket/v1/ticket.proto.
+func (x *Receipt) GetPricePaid() float32 {
+	if x != nil {
+		return x.PricePaid
+	}
+	return 0
+}
+
+func (x *Receipt) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *Receipt) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *Receipt) GetDepartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartsAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Receipt) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *Receipt) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Receipt) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_CONFIRMED
+}
+
+func (x *Receipt) GetHoldExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetPaymentStatus() PaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Receipt) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Receipt) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *Receipt) GetWaitlistedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WaitlistedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Receipt) GetPreference() *SeatPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+func (x *Receipt) GetSwapWith() string {
+	if x != nil {
+		return x.SwapWith
+	}
+	return ""
+}
+
+func (x *Receipt) GetSwapSeat() string {
+	if x != nil {
+		return x.SwapSeat
+	}
+	return ""
+}
+
+func (x *Receipt) GetFareAdjustments() []*FareAdjustment {
+	if x != nil {
+		return x.FareAdjustments
+	}
+	return nil
+}
+
+type ReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email       string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DepartureId string `protobuf:"bytes,2,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+}
+
+func (x *ReceiptRequest) Reset() {
+	*x = ReceiptRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptRequest) ProtoMessage() {}
+
+func (x *ReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReceiptRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReceiptRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+// e.g., "A" or "B"
+type SectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section     string `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	DepartureId string `protobuf:"bytes,2,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+}
+
+func (x *SectionRequest) Reset() {
+	*x = SectionRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionRequest) ProtoMessage() {}
+
+func (x *SectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionRequest.ProtoReflect.Descriptor instead.
+func (*SectionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SectionRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SectionRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+type UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserSeats []*UserSeatInfo `protobuf:"bytes,1,rep,name=user_seats,json=userSeats,proto3" json:"user_seats,omitempty"`
+}
+
+func (x *UserList) Reset() {
+	*x = UserList{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserList) ProtoMessage() {}
+
+func (x *UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserList.ProtoReflect.Descriptor instead.
+func (*UserList) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserList) GetUserSeats() []*UserSeatInfo {
+	if x != nil {
+		return x.UserSeats
+	}
+	return nil
+}
+
+type UserSeatInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Seat string `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *UserSeatInfo) Reset() {
+	*x = UserSeatInfo{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSeatInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSeatInfo) ProtoMessage() {}
+
+func (x *UserSeatInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSeatInfo.ProtoReflect.Descriptor instead.
+func (*UserSeatInfo) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserSeatInfo) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserSeatInfo) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *UserSeatInfo) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *UserSeatInfo) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type RemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email       string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DepartureId string `protobuf:"bytes,2,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+}
+
+func (x *RemoveRequest) Reset() {
+	*x = RemoveRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRequest) ProtoMessage() {}
+
+func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RemoveRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RemoveRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+type ModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email       string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	NewSeat     string `protobuf:"bytes,2,opt,name=new_seat,json=newSeat,proto3" json:"new_seat,omitempty"`
+	DepartureId string `protobuf:"bytes,3,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+}
+
+func (x *ModifyRequest) Reset() {
+	*x = ModifyRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyRequest) ProtoMessage() {}
+
+func (x *ModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyRequest.ProtoReflect.Descriptor instead.
+func (*ModifyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModifyRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ModifyRequest) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+func (x *ModifyRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Refund  *Money `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"` // Set by cancellations of paid bookings
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Response) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Response) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type RoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RoutesRequest) Reset() {
+	*x = RoutesRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutesRequest) ProtoMessage() {}
+
+func (x *RoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutesRequest.ProtoReflect.Descriptor instead.
+func (*RoutesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{12}
+}
+
+type Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Stations []string `protobuf:"bytes,3,rep,name=stations,proto3" json:"stations,omitempty"` // In travel order
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Route) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Route) GetStations() []string {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type RouteList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *RouteList) Reset() {
+	*x = RouteList{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteList) ProtoMessage() {}
+
+func (x *RouteList) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteList.ProtoReflect.Descriptor instead.
+func (*RouteList) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RouteList) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+// All fields are optional filters
+type DeparturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId string `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Date    string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, in UTC
+}
+
+func (x *DeparturesRequest) Reset() {
+	*x = DeparturesRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeparturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeparturesRequest) ProtoMessage() {}
+
+func (x *DeparturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeparturesRequest.ProtoReflect.Descriptor instead.
+func (*DeparturesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeparturesRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *DeparturesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DeparturesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DeparturesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type Departure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteId        string                 `protobuf:"bytes,2,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	DepartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departs_at,json=departsAt,proto3" json:"departs_at,omitempty"`
+	SeatsAvailable int32                  `protobuf:"varint,4,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"`
+}
+
+func (x *Departure) Reset() {
+	*x = Departure{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Departure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Departure) ProtoMessage() {}
+
+func (x *Departure) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Departure.ProtoReflect.Descriptor instead.
+func (*Departure) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Departure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Departure) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *Departure) GetDepartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartsAt
+	}
+	return nil
+}
+
+func (x *Departure) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+type DepartureList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departures []*Departure `protobuf:"bytes,1,rep,name=departures,proto3" json:"departures,omitempty"`
+}
+
+func (x *DepartureList) Reset() {
+	*x = DepartureList{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartureList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartureList) ProtoMessage() {}
+
+func (x *DepartureList) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartureList.ProtoReflect.Descriptor instead.
+func (*DepartureList) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DepartureList) GetDepartures() []*Departure {
+	if x != nil {
+		return x.Departures
+	}
+	return nil
+}
+
+type BookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *BookingRequest) Reset() {
+	*x = BookingRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingRequest) ProtoMessage() {}
+
+func (x *BookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingRequest.ProtoReflect.Descriptor instead.
+func (*BookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type ModifyBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	NewSeat   string `protobuf:"bytes,2,opt,name=new_seat,json=newSeat,proto3" json:"new_seat,omitempty"`
+}
+
+func (x *ModifyBookingRequest) Reset() {
+	*x = ModifyBookingRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingRequest) ProtoMessage() {}
+
+func (x *ModifyBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingRequest.ProtoReflect.Descriptor instead.
+func (*ModifyBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ModifyBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ModifyBookingRequest) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+type MyBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *MyBookingsRequest) Reset() {
+	*x = MyBookingsRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyBookingsRequest) ProtoMessage() {}
+
+func (x *MyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*MyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MyBookingsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ReceiptList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (x *ReceiptList) Reset() {
+	*x = ReceiptList{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptList) ProtoMessage() {}
+
+func (x *ReceiptList) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptList.ProtoReflect.Descriptor instead.
+func (*ReceiptList) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReceiptList) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type QuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TravelClass string `protobuf:"bytes,4,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"` // Optional filter
+	Section     string `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`                            // Optional filter
+}
+
+func (x *QuoteRequest) Reset() {
+	*x = QuoteRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteRequest) ProtoMessage() {}
+
+func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QuoteRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+// Price of the journey in one section
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section     string `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	TravelClass string `protobuf:"bytes,2,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	// Deprecated: Marked as deprecated in ticket/v1/ticket.proto.
+	Price          float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"` // Legacy: approximation of fare
+	SeatsAvailable int32   `protobuf:"varint,4,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"`
+	Fare           *Money  `protobuf:"bytes,5,opt,name=fare,proto3" json:"fare,omitempty"`
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Quote) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Quote) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in ticket/v1/ticket.proto.
+func (x *Quote) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Quote) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+func (x *Quote) GetFare() *Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+type QuoteList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string   `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Quotes      []*Quote `protobuf:"bytes,4,rep,name=quotes,proto3" json:"quotes,omitempty"`
+}
+
+func (x *QuoteList) Reset() {
+	*x = QuoteList{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteList) ProtoMessage() {}
+
+func (x *QuoteList) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteList.ProtoReflect.Descriptor instead.
+func (*QuoteList) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuoteList) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *QuoteList) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *QuoteList) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *QuoteList) GetQuotes() []*Quote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+// An exact amount of money, laid out like google.type.Money
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217
+	Units        int64  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`                                  // Whole units of the currency
+	Nanos        int32  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`                                  // Billionths of a unit, 0 to 999,999,999
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+type HoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	User        *User  `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	TravelClass string `protobuf:"bytes,5,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Seat        string `protobuf:"bytes,6,opt,name=seat,proto3" json:"seat,omitempty"` // Optional: hold this seat instead of the first vacant one
+}
+
+func (x *HoldRequest) Reset() {
+	*x = HoldRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRequest) ProtoMessage() {}
+
+func (x *HoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRequest.ProtoReflect.Descriptor instead.
+func (*HoldRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HoldRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *HoldRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+type ConfirmHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string         `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Price     *Money         `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"` // Optional; when set it must match the held fare
+	Payment   *PaymentMethod `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *ConfirmHoldRequest) Reset() {
+	*x = ConfirmHoldRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldRequest) ProtoMessage() {}
+
+func (x *ConfirmHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConfirmHoldRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ConfirmHoldRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+// Books every passenger or none, seated together where possible
+type GroupPurchaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string         `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string         `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string         `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TravelClass string         `protobuf:"bytes,4,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Passengers  []*User        `protobuf:"bytes,5,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	Payment     *PaymentMethod `protobuf:"bytes,6,opt,name=payment,proto3" json:"payment,omitempty"` // Pays for the whole group
+	Price       *Money         `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`     // Optional; when set it must match the total fare
+}
+
+func (x *GroupPurchaseRequest) Reset() {
+	*x = GroupPurchaseRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupPurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupPurchaseRequest) ProtoMessage() {}
+
+func (x *GroupPurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupPurchaseRequest.ProtoReflect.Descriptor instead.
+func (*GroupPurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GroupPurchaseRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *GroupPurchaseRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GroupPurchaseRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GroupPurchaseRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *GroupPurchaseRequest) GetPassengers() []*User {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *GroupPurchaseRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *GroupPurchaseRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type GroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GroupReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId  string     `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Receipts []*Receipt `protobuf:"bytes,2,rep,name=receipts,proto3" json:"receipts,omitempty"` // One booking per passenger
+	Total    *Money     `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GroupReceipt) Reset() {
+	*x = GroupReceipt{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupReceipt) ProtoMessage() {}
+
+func (x *GroupReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupReceipt.ProtoReflect.Descriptor instead.
+func (*GroupReceipt) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GroupReceipt) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupReceipt) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *GroupReceipt) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type SeatMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // Seat status is for this journey; the whole route when empty
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *SeatMapRequest) Reset() {
+	*x = SeatMapRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapRequest) ProtoMessage() {}
+
+func (x *SeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapRequest.ProtoReflect.Descriptor instead.
+func (*SeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SeatMapRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *SeatMapRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SeatMapRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Every seat of a departure and whether it can be booked, without the
+// passengers in them
+type SeatMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string        `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Sections    []*SectionMap `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	Cursor      string        `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"` // Pass to WatchSeats to follow changes from this map on
+}
+
+func (x *SeatMap) Reset() {
+	*x = SeatMap{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMap) ProtoMessage() {}
+
+func (x *SeatMap) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMap.ProtoReflect.Descriptor instead.
+func (*SeatMap) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SeatMap) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *SeatMap) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SeatMap) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SeatMap) GetSections() []*SectionMap {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *SeatMap) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SectionMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TravelClass string      `protobuf:"bytes,2,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Rows        int32       `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	SeatsPerRow int32       `protobuf:"varint,4,opt,name=seats_per_row,json=seatsPerRow,proto3" json:"seats_per_row,omitempty"`
+	Fare        *Money      `protobuf:"bytes,5,opt,name=fare,proto3" json:"fare,omitempty"` // Price of the journey in this section
+	Seats       []*SeatInfo `protobuf:"bytes,6,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *SectionMap) Reset() {
+	*x = SectionMap{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionMap) ProtoMessage() {}
+
+func (x *SectionMap) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionMap.ProtoReflect.Descriptor instead.
+func (*SectionMap) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SectionMap) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SectionMap) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *SectionMap) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SectionMap) GetSeatsPerRow() int32 {
+	if x != nil {
+		return x.SeatsPerRow
+	}
+	return 0
+}
+
+func (x *SectionMap) GetFare() *Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+func (x *SectionMap) GetSeats() []*SeatInfo {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type SeatInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string     `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Row    int32      `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`       // 1-based
+	Column int32      `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"` // 0-based position within the row
+	Window bool       `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	Aisle  bool       `protobuf:"varint,5,opt,name=aisle,proto3" json:"aisle,omitempty"`
+	Status SeatStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ticket.v1.SeatStatus" json:"status,omitempty"`
+}
+
+func (x *SeatInfo) Reset() {
+	*x = SeatInfo{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatInfo) ProtoMessage() {}
+
+func (x *SeatInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatInfo.ProtoReflect.Descriptor instead.
+func (*SeatInfo) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SeatInfo) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SeatInfo) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatInfo) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatInfo) GetWindow() bool {
+	if x != nil {
+		return x.Window
+	}
+	return false
+}
+
+func (x *SeatInfo) GetAisle() bool {
+	if x != nil {
+		return x.Aisle
+	}
+	return false
+}
+
+func (x *SeatInfo) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_FREE
+}
+
+type WatchSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	Cursor      string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // Resume after this event; only new changes when empty
+}
+
+func (x *WatchSeatsRequest) Reset() {
+	*x = WatchSeatsRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSeatsRequest) ProtoMessage() {}
+
+func (x *WatchSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSeatsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchSeatsRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *WatchSeatsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// A change of the status of a seat on part of the route
+type SeatEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor      string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // Pass to WatchSeats to resume after this event
+	DepartureId string                 `protobuf:"bytes,2,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	Seat        string                 `protobuf:"bytes,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	Section     string                 `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	From        string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"` // The stretch of the route the change applies to
+	To          string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Status      SeatStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=ticket.v1.SeatStatus" json:"status,omitempty"` // New status of the seat from from to to
+	Time        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *SeatEvent) Reset() {
+	*x = SeatEvent{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatEvent) ProtoMessage() {}
+
+func (x *SeatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatEvent.ProtoReflect.Descriptor instead.
+func (*SeatEvent) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SeatEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SeatEvent) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *SeatEvent) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *SeatEvent) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SeatEvent) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SeatEvent) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SeatEvent) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_FREE
+}
+
+func (x *SeatEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Offers to swap seats with another booking, or accepts its offer. Both
+// holders must call SwapSeats naming each other's booking.
+type SwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId      string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Email          string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // Of the holder of booking_id
+	OtherBookingId string `protobuf:"bytes,3,opt,name=other_booking_id,json=otherBookingId,proto3" json:"other_booking_id,omitempty"`
+}
+
+func (x *SwapRequest) Reset() {
+	*x = SwapRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRequest) ProtoMessage() {}
+
+func (x *SwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRequest.ProtoReflect.Descriptor instead.
+func (*SwapRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SwapRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *SwapRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SwapRequest) GetOtherBookingId() string {
+	if x != nil {
+		return x.OtherBookingId
+	}
+	return ""
+}
+
+type SwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  SwapStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ticket.v1.SwapStatus" json:"status,omitempty"`
+	Receipt *Receipt   `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"` // The caller's booking
+}
+
+func (x *SwapResponse) Reset() {
+	*x = SwapResponse{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapResponse) ProtoMessage() {}
+
+func (x *SwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
+func (*SwapResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SwapResponse) GetStatus() SwapStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SwapStatus_SWAP_STATUS_OFFERED
+}
+
+func (x *SwapResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// A fare difference paid when a booking moved to a dearer seat. Refunds
+// are taken from the latest adjustments first, then from the original payment.
+type FareAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Refunded      *Money `protobuf:"bytes,3,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (x *FareAdjustment) Reset() {
+	*x = FareAdjustment{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FareAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareAdjustment) ProtoMessage() {}
+
+func (x *FareAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareAdjustment.ProtoReflect.Descriptor instead.
+func (*FareAdjustment) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FareAdjustment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *FareAdjustment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FareAdjustment) GetRefunded() *Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+// Moves a booking to a seat that may be priced differently, e.g. an upgrade
+// to first class. Give either new_seat or section.
+type ChangeSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string         `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	NewSeat   string         `protobuf:"bytes,2,opt,name=new_seat,json=newSeat,proto3" json:"new_seat,omitempty"`
+	Section   string         `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"` // Any vacant seat of this section
+	Payment   *PaymentMethod `protobuf:"bytes,4,opt,name=payment,proto3" json:"payment,omitempty"` // Pays the difference when the new seat is dearer
+	Price     *Money         `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`     // Optional: the new fare the client agreed to
+}
+
+func (x *ChangeSeatRequest) Reset() {
+	*x = ChangeSeatRequest{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatRequest) ProtoMessage() {}
+
+func (x *ChangeSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSeatRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeSeatRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *ChangeSeatRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type SeatChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt  *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Charged  *Money   `protobuf:"bytes,2,opt,name=charged,proto3" json:"charged,omitempty"`   // Set when the difference was paid
+	Refunded *Money   `protobuf:"bytes,3,opt,name=refunded,proto3" json:"refunded,omitempty"` // Set when the difference was paid back
+}
+
+func (x *SeatChange) Reset() {
+	*x = SeatChange{}
+	mi := &file_ticket_v1_ticket_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatChange) ProtoMessage() {}
+
+func (x *SeatChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v1_ticket_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatChange.ProtoReflect.Descriptor instead.
+func (*SeatChange) Descriptor() ([]byte, []int) {
+	return file_ticket_v1_ticket_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SeatChange) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *SeatChange) GetCharged() *Money {
+	if x != nil {
+		return x.Charged
+	}
+	return nil
+}
+
+func (x *SeatChange) GetRefunded() *Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+var File_ticket_v1_ticket_proto protoreflect.FileDescriptor
+
+var file_ticket_v1_ticket_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x03, 0x0a,
+	0x0f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x02, 0x30, 0x01, 0x18, 0x01, 0x52,
+	0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc0, 0x01, 0x0a,
+	0x0e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x33, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18,
+	0x0f, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24,
+	0x52, 0x0d, 0x6e, 0x65, 0x61, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22,
+	0x2e, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0x8a, 0xb5, 0x18, 0x03, 0x20, 0xc8, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x79, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01,
+	0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xaf, 0x07, 0x0a, 0x07, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x61,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x42, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x77, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x77,
+	0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x72,
+	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x66, 0x61, 0x72,
+	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x0e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a,
+	0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01, 0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52,
+	0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x0e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x22, 0x6b, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x5d,
+	0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01, 0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0x82, 0x01,
+	0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01, 0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x23, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x64, 0x22, 0x4e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x35, 0x0a, 0x09,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x20, 0x64, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22,
+	0x8a, 0xb5, 0x18, 0x1e, 0x2a, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x7d, 0x24, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x65, 0x61, 0x74, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x45, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x0e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d,
+	0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32,
+	0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x22, 0x36, 0x0a, 0x11, 0x4d, 0x79, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a, 0xb5, 0x18,
+	0x07, 0x08, 0x01, 0x10, 0x01, 0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22,
+	0x3d, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0xba,
+	0x01, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x0b, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x20, 0x64, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x05,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x65, 0x61, 0x74, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x66, 0x61, 0x72, 0x65, 0x22, 0x7c, 0x0a, 0x09, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x28, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x05, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x12, 0x35, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x8a, 0xb5, 0x18, 0x0c, 0x2a,
+	0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x30, 0x01,
+	0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x30, 0x01, 0x52, 0x05,
+	0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x32, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x20, 0x64, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xb7, 0x02, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x38,
+	0x01, 0x40, 0x14, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12,
+	0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a,
+	0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d,
+	0x7b, 0x38, 0x7d, 0x24, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x81, 0x01,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x6f, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20,
+	0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x9b, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x08,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65,
+	0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x12, 0x24,
+	0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04,
+	0x66, 0x61, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x69, 0x73, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x69, 0x73, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x11, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20,
+	0x64, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xf7, 0x01, 0x0a, 0x09, 0x53, 0x65,
+	0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a,
+	0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01,
+	0x10, 0x01, 0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3f, 0x0a, 0x10,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d,
+	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0e, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x6b, 0x0a,
+	0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x46,
+	0x61, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xea, 0x01, 0x0a,
+	0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d,
+	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x09, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x20, 0x64, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x20, 0x64, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x53, 0x65,
+	0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x2a, 0x58, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x41, 0x54, 0x5f,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x49, 0x53, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x83, 0x01, 0x0a, 0x0d, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18,
+	0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4f,
+	0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c,
+	0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x2a, 0xaf, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x04, 0x2a, 0x69, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x46, 0x52, 0x45, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x3e, 0x0a,
+	0x0a, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x32, 0x99, 0x0c,
+	0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x58, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x1a, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x7d, 0x12, 0x69, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x56, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x7d, 0x12, 0x5e, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x53, 0x65, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x1a,
+	0x16, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x7d, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x79, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x08, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61,
+	0x74, 0x12, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1d,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x19, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x42, 0x9d, 0x01, 0x92, 0x41, 0x51, 0x12,
+	0x4f, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x20, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x41, 0x50, 0x49, 0x12, 0x2f, 0x4a, 0x53, 0x4f,
+	0x4e, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x02, 0x76, 0x31,
+	0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61,
+	0x6e, 0x64, 0x61, 0x6e, 0x6b, 0x75, 0x6d, 0x61, 0x72, 0x32, 0x35, 0x31, 0x37, 0x2f, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31,
+	0x3b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_ticket_v1_ticket_proto_rawDescOnce sync.Once
+	file_ticket_v1_ticket_proto_rawDescData = file_ticket_v1_ticket_proto_rawDesc
+)
+
+func file_ticket_v1_ticket_proto_rawDescGZIP() []byte {
+	file_ticket_v1_ticket_proto_rawDescOnce.Do(func() {
+		file_ticket_v1_ticket_proto_rawDescData = protoimpl.X.CompressGZIP(file_ticket_v1_ticket_proto_rawDescData)
+	})
+	return file_ticket_v1_ticket_proto_rawDescData
+}
+
+var file_ticket_v1_ticket_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_ticket_v1_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_ticket_v1_ticket_proto_goTypes = []any{
+	(SeatPosition)(0),             // 0: ticket.v1.SeatPosition
+	(BookingStatus)(0),            // 1: ticket.v1.BookingStatus
+	(PaymentStatus)(0),            // 2: ticket.v1.PaymentStatus
+	(SeatStatus)(0),               // 3: ticket.v1.SeatStatus
+	(SwapStatus)(0),               // 4: ticket.v1.SwapStatus
+	(*PurchaseRequest)(nil),       // 5: ticket.v1.PurchaseRequest
+	(*SeatPreference)(nil),        // 6: ticket.v1.SeatPreference
+	(*PaymentMethod)(nil),         // 7: ticket.v1.PaymentMethod
+	(*User)(nil),                  // 8: ticket.v1.User
+	(*Receipt)(nil),               // 9: ticket.v1.Receipt
+	(*ReceiptRequest)(nil),        // 10: ticket.v1.ReceiptRequest
+	(*SectionRequest)(nil),        // 11: ticket.v1.SectionRequest
+	(*UserList)(nil),              // 12: ticket.v1.UserList
+	(*UserSeatInfo)(nil),          // 13: ticket.v1.UserSeatInfo
+	(*RemoveRequest)(nil),         // 14: ticket.v1.RemoveRequest
+	(*ModifyRequest)(nil),         // 15: ticket.v1.ModifyRequest
+	(*Response)(nil),              // 16: ticket.v1.Response
+	(*RoutesRequest)(nil),         // 17: ticket.v1.RoutesRequest
+	(*Route)(nil),                 // 18: ticket.v1.Route
+	(*RouteList)(nil),             // 19: ticket.v1.RouteList
+	(*DeparturesRequest)(nil),     // 20: ticket.v1.DeparturesRequest
+	(*Departure)(nil),             // 21: ticket.v1.Departure
+	(*DepartureList)(nil),         // 22: ticket.v1.DepartureList
+	(*BookingRequest)(nil),        // 23: ticket.v1.BookingRequest
+	(*ModifyBookingRequest)(nil),  // 24: ticket.v1.ModifyBookingRequest
+	(*MyBookingsRequest)(nil),     // 25: ticket.v1.MyBookingsRequest
+	(*ReceiptList)(nil),           // 26: ticket.v1.ReceiptList
+	(*QuoteRequest)(nil),          // 27: ticket.v1.QuoteRequest
+	(*Quote)(nil),                 // 28: ticket.v1.Quote
+	(*QuoteList)(nil),             // 29: ticket.v1.QuoteList
+	(*Money)(nil),                 // 30: ticket.v1.Money
+	(*HoldRequest)(nil),           // 31: ticket.v1.HoldRequest
+	(*ConfirmHoldRequest)(nil),    // 32: ticket.v1.ConfirmHoldRequest
+	(*GroupPurchaseRequest)(nil),  // 33: ticket.v1.GroupPurchaseRequest
+	(*GroupRequest)(nil),          // 34: ticket.v1.GroupRequest
+	(*GroupReceipt)(nil),          // 35: ticket.v1.GroupReceipt
+	(*SeatMapRequest)(nil),        // 36: ticket.v1.SeatMapRequest
+	(*SeatMap)(nil),               // 37: ticket.v1.SeatMap
+	(*SectionMap)(nil),            // 38: ticket.v1.SectionMap
+	(*SeatInfo)(nil),              // 39: ticket.v1.SeatInfo
+	(*WatchSeatsRequest)(nil),     // 40: ticket.v1.WatchSeatsRequest
+	(*SeatEvent)(nil),             // 41: ticket.v1.SeatEvent
+	(*SwapRequest)(nil),           // 42: ticket.v1.SwapRequest
+	(*SwapResponse)(nil),          // 43: ticket.v1.SwapResponse
+	(*FareAdjustment)(nil),        // 44: ticket.v1.FareAdjustment
+	(*ChangeSeatRequest)(nil),     // 45: ticket.v1.ChangeSeatRequest
+	(*SeatChange)(nil),            // 46: ticket.v1.SeatChange
+	(*timestamppb.Timestamp)(nil), // 47: google.protobuf.Timestamp
+}
+var file_ticket_v1_ticket_proto_depIdxs = []int32{
+	8,  // 0: ticket.v1.PurchaseRequest.user:type_name -> ticket.v1.User
+	30, // 1: ticket.v1.PurchaseRequest.price:type_name -> ticket.v1.Money
+	7,  // 2: ticket.v1.PurchaseRequest.payment:type_name -> ticket.v1.PaymentMethod
+	6,  // 3: ticket.v1.PurchaseRequest.preference:type_name -> ticket.v1.SeatPreference
+	0,  // 4: ticket.v1.SeatPreference.position:type_name -> ticket.v1.SeatPosition
+	8,  // 5: ticket.v1.Receipt.user:type_name -> ticket.v1.User
+	47, // 6: ticket.v1.Receipt.departs_at:type_name -> google.protobuf.Timestamp
+	30, // 7: ticket.v1.Receipt.price:type_name -> ticket.v1.Money
+	1,  // 8: ticket.v1.Receipt.status:type_name -> ticket.v1.BookingStatus
+	47, // 9: ticket.v1.Receipt.hold_expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 10: ticket.v1.Receipt.payment_status:type_name -> ticket.v1.PaymentStatus
+	47, // 11: ticket.v1.Receipt.cancelled_at:type_name -> google.protobuf.Timestamp
+	30, // 12: ticket.v1.Receipt.refund:type_name -> ticket.v1.Money
+	47, // 13: ticket.v1.Receipt.waitlisted_at:type_name -> google.protobuf.Timestamp
+	6,  // 14: ticket.v1.Receipt.preference:type_name -> ticket.v1.SeatPreference
+	44, // 15: ticket.v1.Receipt.fare_adjustments:type_name -> ticket.v1.FareAdjustment
+	13, // 16: ticket.v1.UserList.user_seats:type_name -> ticket.v1.UserSeatInfo
+	8,  // 17: ticket.v1.UserSeatInfo.user:type_name -> ticket.v1.User
+	30, // 18: ticket.v1.Response.refund:type_name -> ticket.v1.Money
+	18, // 19: ticket.v1.RouteList.routes:type_name -> ticket.v1.Route
+	47, // 20: ticket.v1.Departure.departs_at:type_name -> google.protobuf.Timestamp
+	21, // 21: ticket.v1.DepartureList.departures:type_name -> ticket.v1.Departure
+	9,  // 22: ticket.v1.ReceiptList.receipts:type_name -> ticket.v1.Receipt
+	30, // 23: ticket.v1.Quote.fare:type_name -> ticket.v1.Money
+	28, // 24: ticket.v1.QuoteList.quotes:type_name -> ticket.v1.Quote
+	8,  // 25: ticket.v1.HoldRequest.user:type_name -> ticket.v1.User
+	30, // 26: ticket.v1.ConfirmHoldRequest.price:type_name -> ticket.v1.Money
+	7,  // 27: ticket.v1.ConfirmHoldRequest.payment:type_name -> ticket.v1.PaymentMethod
+	8,  // 28: ticket.v1.GroupPurchaseRequest.passengers:type_name -> ticket.v1.User
+	7,  // 29: ticket.v1.GroupPurchaseRequest.payment:type_name -> ticket.v1.PaymentMethod
+	30, // 30: ticket.v1.GroupPurchaseRequest.price:type_name -> ticket.v1.Money
+	9,  // 31: ticket.v1.GroupReceipt.receipts:type_name -> ticket.v1.Receipt
+	30, // 32: ticket.v1.GroupReceipt.total:type_name -> ticket.v1.Money
+	38, // 33: ticket.v1.SeatMap.sections:type_name -> ticket.v1.SectionMap
+	30, // 34: ticket.v1.SectionMap.fare:type_name -> ticket.v1.Money
+	39, // 35: ticket.v1.SectionMap.seats:type_name -> ticket.v1.SeatInfo
+	3,  // 36: ticket.v1.SeatInfo.status:type_name -> ticket.v1.SeatStatus
+	3,  // 37: ticket.v1.SeatEvent.status:type_name -> ticket.v1.SeatStatus
+	47, // 38: ticket.v1.SeatEvent.time:type_name -> google.protobuf.Timestamp
+	4,  // 39: ticket.v1.SwapResponse.status:type_name -> ticket.v1.SwapStatus
+	9,  // 40: ticket.v1.SwapResponse.receipt:type_name -> ticket.v1.Receipt
+	30, // 41: ticket.v1.FareAdjustment.amount:type_name -> ticket.v1.Money
+	30, // 42: ticket.v1.FareAdjustment.refunded:type_name -> ticket.v1.Money
+	7,  // 43: ticket.v1.ChangeSeatRequest.payment:type_name -> ticket.v1.PaymentMethod
+	30, // 44: ticket.v1.ChangeSeatRequest.price:type_name -> ticket.v1.Money
+	9,  // 45: ticket.v1.SeatChange.receipt:type_name -> ticket.v1.Receipt
+	30, // 46: ticket.v1.SeatChange.charged:type_name -> ticket.v1.Money
+	30, // 47: ticket.v1.SeatChange.refunded:type_name -> ticket.v1.Money
+	5,  // 48: ticket.v1.TicketService.PurchaseTicket:input_type -> ticket.v1.PurchaseRequest
+	10, // 49: ticket.v1.TicketService.GetReceipt:input_type -> ticket.v1.ReceiptRequest
+	11, // 50: ticket.v1.TicketService.GetAllocatedUsers:input_type -> ticket.v1.SectionRequest
+	14, // 51: ticket.v1.TicketService.RemoveUser:input_type -> ticket.v1.RemoveRequest
+	15, // 52: ticket.v1.TicketService.ModifySeat:input_type -> ticket.v1.ModifyRequest
+	17, // 53: ticket.v1.TicketService.ListRoutes:input_type -> ticket.v1.RoutesRequest
+	20, // 54: ticket.v1.TicketService.ListDepartures:input_type -> ticket.v1.DeparturesRequest
+	23, // 55: ticket.v1.TicketService.GetBooking:input_type -> ticket.v1.BookingRequest
+	23, // 56: ticket.v1.TicketService.CancelBooking:input_type -> ticket.v1.BookingRequest
+	24, // 57: ticket.v1.TicketService.ModifyBooking:input_type -> ticket.v1.ModifyBookingRequest
+	25, // 58: ticket.v1.TicketService.ListMyBookings:input_type -> ticket.v1.MyBookingsRequest
+	27, // 59: ticket.v1.TicketService.GetQuote:input_type -> ticket.v1.QuoteRequest
+	31, // 60: ticket.v1.TicketService.HoldSeat:input_type -> ticket.v1.HoldRequest
+	32, // 61: ticket.v1.TicketService.ConfirmHold:input_type -> ticket.v1.ConfirmHoldRequest
+	33, // 62: ticket.v1.TicketService.PurchaseGroup:input_type -> ticket.v1.GroupPurchaseRequest
+	34, // 63: ticket.v1.TicketService.GetGroup:input_type -> ticket.v1.GroupRequest
+	34, // 64: ticket.v1.TicketService.CancelGroup:input_type -> ticket.v1.GroupRequest
+	36, // 65: ticket.v1.TicketService.GetSeatMap:input_type -> ticket.v1.SeatMapRequest
+	40, // 66: ticket.v1.TicketService.WatchSeats:input_type -> ticket.v1.WatchSeatsRequest
+	42, // 67: ticket.v1.TicketService.SwapSeats:input_type -> ticket.v1.SwapRequest
+	45, // 68: ticket.v1.TicketService.ChangeSeat:input_type -> ticket.v1.ChangeSeatRequest
+	9,  // 69: ticket.v1.TicketService.PurchaseTicket:output_type -> ticket.v1.Receipt
+	9,  // 70: ticket.v1.TicketService.GetReceipt:output_type -> ticket.v1.Receipt
+	12, // 71: ticket.v1.TicketService.GetAllocatedUsers:output_type -> ticket.v1.UserList
+	16, // 72: ticket.v1.TicketService.RemoveUser:output_type -> ticket.v1.Response
+	16, // 73: ticket.v1.TicketService.ModifySeat:output_type -> ticket.v1.Response
+	19, // 74: ticket.v1.TicketService.ListRoutes:output_type -> ticket.v1.RouteList
+	22, // 75: ticket.v1.TicketService.ListDepartures:output_type -> ticket.v1.DepartureList
+	9,  // 76: ticket.v1.TicketService.GetBooking:output_type -> ticket.v1.Receipt
+	16, // 77: ticket.v1.TicketService.CancelBooking:output_type -> ticket.v1.Response
+	16, // 78: ticket.v1.TicketService.ModifyBooking:output_type -> ticket.v1.Response
+	26, // 79: ticket.v1.TicketService.ListMyBookings:output_type -> ticket.v1.ReceiptList
+	29, // 80: ticket.v1.TicketService.GetQuote:output_type -> ticket.v1.QuoteList
+	9,  // 81: ticket.v1.TicketService.HoldSeat:output_type -> ticket.v1.Receipt
+	9,  // 82: ticket.v1.TicketService.ConfirmHold:output_type -> ticket.v1.Receipt
+	35, // 83: ticket.v1.TicketService.PurchaseGroup:output_type -> ticket.v1.GroupReceipt
+	35, // 84: ticket.v1.TicketService.GetGroup:output_type -> ticket.v1.GroupReceipt
+	16, // 85: ticket.v1.TicketService.CancelGroup:output_type -> ticket.v1.Response
+	37, // 86: ticket.v1.TicketService.GetSeatMap:output_type -> ticket.v1.SeatMap
+	41, // 87: ticket.v1.TicketService.WatchSeats:output_type -> ticket.v1.SeatEvent
+	43, // 88: ticket.v1.TicketService.SwapSeats:output_type -> ticket.v1.SwapResponse
+	46, // 89: ticket.v1.TicketService.ChangeSeat:output_type -> ticket.v1.SeatChange
+	69, // [69:90] is the sub-list for method output_type
+	48, // [48:69] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_ticket_v1_ticket_proto_init() }
+func file_ticket_v1_ticket_proto_init() {
+	if File_ticket_v1_ticket_proto != nil {
+		return
+	}
+	file_ticket_v1_validate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ticket_v1_ticket_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   42,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ticket_v1_ticket_proto_goTypes,
+		DependencyIndexes: file_ticket_v1_ticket_proto_depIdxs,
+		EnumInfos:         file_ticket_v1_ticket_proto_enumTypes,
+		MessageInfos:      file_ticket_v1_ticket_proto_msgTypes,
+	}.Build()
+	File_ticket_v1_ticket_proto = out.File
+	file_ticket_v1_ticket_proto_rawDesc = nil
+	file_ticket_v1_ticket_proto_goTypes = nil
+	file_ticket_v1_ticket_proto_depIdxs = nil
+}
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ticket/v1/ticket.proto
+
+package ticketv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TicketService_PurchaseTicket_FullMethodName    = "/ticket.v1.TicketService/PurchaseTicket"
+	TicketService_GetReceipt_FullMethodName        = "/ticket.v1.TicketService/GetReceipt"
+	TicketService_GetAllocatedUsers_FullMethodName = "/ticket.v1.TicketService/GetAllocatedUsers"
+	TicketService_RemoveUser_FullMethodName        = "/ticket.v1.TicketService/RemoveUser"
+	TicketService_ModifySeat_FullMethodName        = "/ticket.v1.TicketService/ModifySeat"
+	TicketService_ListRoutes_FullMethodName        = "/ticket.v1.TicketService/ListRoutes"
+	TicketService_ListDepartures_FullMethodName    = "/ticket.v1.TicketService/ListDepartures"
+	TicketService_GetBooking_FullMethodName        = "/ticket.v1.TicketService/GetBooking"
+	TicketService_CancelBooking_FullMethodName     = "/ticket.v1.TicketService/CancelBooking"
+	TicketService_ModifyBooking_FullMethodName     = "/ticket.v1.TicketService/ModifyBooking"
+	TicketService_ListMyBookings_FullMethodName    = "/ticket.v1.TicketService/ListMyBookings"
+	TicketService_GetQuote_FullMethodName          = "/ticket.v1.TicketService/GetQuote"
+	TicketService_HoldSeat_FullMethodName          = "/ticket.v1.TicketService/HoldSeat"
+	TicketService_ConfirmHold_FullMethodName       = "/ticket.v1.TicketService/ConfirmHold"
+	TicketService_PurchaseGroup_FullMethodName     = "/ticket.v1.TicketService/PurchaseGroup"
+	TicketService_GetGroup_FullMethodName          = "/ticket.v1.TicketService/GetGroup"
+	TicketService_CancelGroup_FullMethodName       = "/ticket.v1.TicketService/CancelGroup"
+	TicketService_GetSeatMap_FullMethodName        = "/ticket.v1.TicketService/GetSeatMap"
+	TicketService_WatchSeats_FullMethodName        = "/ticket.v1.TicketService/WatchSeats"
+	TicketService_SwapSeats_FullMethodName         = "/ticket.v1.TicketService/SwapSeats"
+	TicketService_ChangeSeat_FullMethodName        = "/ticket.v1.TicketService/ChangeSeat"
+)
+
+// TicketServiceClient is the client API for TicketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service definition. The RPCs with an HTTP binding are also served as JSON
+// over HTTP by the gateway; fields not in the path are read from the query
+// string of GET and DELETE requests.
+type TicketServiceClient interface {
+	PurchaseTicket(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*Receipt, error)
+	GetReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
+	GetAllocatedUsers(ctx context.Context, in *SectionRequest, opts ...grpc.CallOption) (*UserList, error)
+	RemoveUser(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*Response, error)
+	ModifySeat(ctx context.Context, in *ModifyRequest, opts ...grpc.CallOption) (*Response, error)
+	ListRoutes(ctx context.Context, in *RoutesRequest, opts ...grpc.CallOption) (*RouteList, error)
+	ListDepartures(ctx context.Context, in *DeparturesRequest, opts ...grpc.CallOption) (*DepartureList, error)
+	GetBooking(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Receipt, error)
+	CancelBooking(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Response, error)
+	ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*Response, error)
+	ListMyBookings(ctx context.Context, in *MyBookingsRequest, opts ...grpc.CallOption) (*ReceiptList, error)
+	GetQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteList, error)
+	HoldSeat(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Receipt, error)
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*Receipt, error)
+	PurchaseGroup(ctx context.Context, in *GroupPurchaseRequest, opts ...grpc.CallOption) (*GroupReceipt, error)
+	GetGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupReceipt, error)
+	CancelGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Response, error)
+	GetSeatMap(ctx context.Context, in *SeatMapRequest, opts ...grpc.CallOption) (*SeatMap, error)
+	WatchSeats(ctx context.Context, in *WatchSeatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SeatEvent], error)
+	SwapSeats(ctx context.Context, in *SwapRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*SeatChange, error)
+}
+
+type ticketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicketServiceClient(cc grpc.ClientConnInterface) TicketServiceClient {
+	return &ticketServiceClient{cc}
+}
+
+func (c *ticketServiceClient) PurchaseTicket(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, TicketService_PurchaseTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, TicketService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetAllocatedUsers(ctx context.Context, in *SectionRequest, opts ...grpc.CallOption) (*UserList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserList)
+	err := c.cc.Invoke(ctx, TicketService_GetAllocatedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) RemoveUser(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, TicketService_RemoveUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ModifySeat(ctx context.Context, in *ModifyRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, TicketService_ModifySeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListRoutes(ctx context.Context, in *RoutesRequest, opts ...grpc.CallOption) (*RouteList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteList)
+	err := c.cc.Invoke(ctx, TicketService_ListRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListDepartures(ctx context.Context, in *DeparturesRequest, opts ...grpc.CallOption) (*DepartureList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartureList)
+	err := c.cc.Invoke(ctx, TicketService_ListDepartures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetBooking(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, TicketService_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelBooking(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, TicketService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, TicketService_ModifyBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListMyBookings(ctx context.Context, in *MyBookingsRequest, opts ...grpc.CallOption) (*ReceiptList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiptList)
+	err := c.cc.Invoke(ctx, TicketService_ListMyBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteList)
+	err := c.cc.Invoke(ctx, TicketService_GetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) HoldSeat(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, TicketService_HoldSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, TicketService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) PurchaseGroup(ctx context.Context, in *GroupPurchaseRequest, opts ...grpc.CallOption) (*GroupReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupReceipt)
+	err := c.cc.Invoke(ctx, TicketService_PurchaseGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupReceipt)
+	err := c.cc.Invoke(ctx, TicketService_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelGroup(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, TicketService_CancelGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetSeatMap(ctx context.Context, in *SeatMapRequest, opts ...grpc.CallOption) (*SeatMap, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatMap)
+	err := c.cc.Invoke(ctx, TicketService_GetSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) WatchSeats(ctx context.Context, in *WatchSeatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SeatEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TicketService_ServiceDesc.Streams[0], TicketService_WatchSeats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSeatsRequest, SeatEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicketService_WatchSeatsClient = grpc.ServerStreamingClient[SeatEvent]
+
+func (c *ticketServiceClient) SwapSeats(ctx context.Context, in *SwapRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapResponse)
+	err := c.cc.Invoke(ctx, TicketService_SwapSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*SeatChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatChange)
+	err := c.cc.Invoke(ctx, TicketService_ChangeSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicketServiceServer is the server API for TicketService service.
+// All implementations must embed UnimplementedTicketServiceServer
+// for forward compatibility.
+//
+// Service definition. The RPCs with an HTTP binding are also served as JSON
+// over HTTP by the gateway; fields not in the path are read from the query
+// string of GET and DELETE requests.
+type TicketServiceServer interface {
+	PurchaseTicket(context.Context, *PurchaseRequest) (*Receipt, error)
+	GetReceipt(context.Context, *ReceiptRequest) (*Receipt, error)
+	GetAllocatedUsers(context.Context, *SectionRequest) (*UserList, error)
+	RemoveUser(context.Context, *RemoveRequest) (*Response, error)
+	ModifySeat(context.Context, *ModifyRequest) (*Response, error)
+	ListRoutes(context.Context, *RoutesRequest) (*RouteList, error)
+	ListDepartures(context.Context, *DeparturesRequest) (*DepartureList, error)
+	GetBooking(context.Context, *BookingRequest) (*Receipt, error)
+	CancelBooking(context.Context, *BookingRequest) (*Response, error)
+	ModifyBooking(context.Context, *ModifyBookingRequest) (*Response, error)
+	ListMyBookings(context.Context, *MyBookingsRequest) (*ReceiptList, error)
+	GetQuote(context.Context, *QuoteRequest) (*QuoteList, error)
+	HoldSeat(context.Context, *HoldRequest) (*Receipt, error)
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*Receipt, error)
+	PurchaseGroup(context.Context, *GroupPurchaseRequest) (*GroupReceipt, error)
+	GetGroup(context.Context, *GroupRequest) (*GroupReceipt, error)
+	CancelGroup(context.Context, *GroupRequest) (*Response, error)
+	GetSeatMap(context.Context, *SeatMapRequest) (*SeatMap, error)
+	WatchSeats(*WatchSeatsRequest, grpc.ServerStreamingServer[SeatEvent]) error
+	SwapSeats(context.Context, *SwapRequest) (*SwapResponse, error)
+	ChangeSeat(context.Context, *ChangeSeatRequest) (*SeatChange, error)
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+// UnimplementedTicketServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTicketServiceServer struct{}
+
+func (UnimplementedTicketServiceServer) PurchaseTicket(context.Context, *PurchaseRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) GetReceipt(context.Context, *ReceiptRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedTicketServiceServer) GetAllocatedUsers(context.Context, *SectionRequest) (*UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllocatedUsers not implemented")
+}
+func (UnimplementedTicketServiceServer) RemoveUser(context.Context, *RemoveRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
+}
+func (UnimplementedTicketServiceServer) ModifySeat(context.Context, *ModifyRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifySeat not implemented")
+}
+func (UnimplementedTicketServiceServer) ListRoutes(context.Context, *RoutesRequest) (*RouteList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoutes not implemented")
+}
+func (UnimplementedTicketServiceServer) ListDepartures(context.Context, *DeparturesRequest) (*DepartureList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartures not implemented")
+}
+func (UnimplementedTicketServiceServer) GetBooking(context.Context, *BookingRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelBooking(context.Context, *BookingRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) ModifyBooking(context.Context, *ModifyBookingRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) ListMyBookings(context.Context, *MyBookingsRequest) (*ReceiptList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBookings not implemented")
+}
+func (UnimplementedTicketServiceServer) GetQuote(context.Context, *QuoteRequest) (*QuoteList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedTicketServiceServer) HoldSeat(context.Context, *HoldRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeat not implemented")
+}
+func (UnimplementedTicketServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedTicketServiceServer) PurchaseGroup(context.Context, *GroupPurchaseRequest) (*GroupReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseGroup not implemented")
+}
+func (UnimplementedTicketServiceServer) GetGroup(context.Context, *GroupRequest) (*GroupReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelGroup(context.Context, *GroupRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGroup not implemented")
+}
+func (UnimplementedTicketServiceServer) GetSeatMap(context.Context, *SeatMapRequest) (*SeatMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedTicketServiceServer) WatchSeats(*WatchSeatsRequest, grpc.ServerStreamingServer[SeatEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSeats not implemented")
+}
+func (UnimplementedTicketServiceServer) SwapSeats(context.Context, *SwapRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSeats not implemented")
+}
+func (UnimplementedTicketServiceServer) ChangeSeat(context.Context, *ChangeSeatRequest) (*SeatChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSeat not implemented")
+}
+func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
+func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketServiceServer will
+// result in compilation errors.
+type UnsafeTicketServiceServer interface {
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTicketServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TicketService_ServiceDesc, srv)
+}
+
+func _TicketService_PurchaseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).PurchaseTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_PurchaseTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).PurchaseTicket(ctx, req.(*PurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetReceipt(ctx, req.(*ReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetAllocatedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetAllocatedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetAllocatedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetAllocatedUsers(ctx, req.(*SectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).RemoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_RemoveUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).RemoveUser(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ModifySeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ModifySeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ModifySeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ModifySeat(ctx, req.(*ModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListRoutes(ctx, req.(*RoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListDepartures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeparturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListDepartures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListDepartures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListDepartures(ctx, req.(*DeparturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetBooking(ctx, req.(*BookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelBooking(ctx, req.(*BookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ModifyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ModifyBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ModifyBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ModifyBooking(ctx, req.(*ModifyBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MyBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListMyBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListMyBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListMyBookings(ctx, req.(*MyBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_HoldSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).HoldSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_HoldSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).HoldSeat(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_PurchaseGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).PurchaseGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_PurchaseGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).PurchaseGroup(ctx, req.(*GroupPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelGroup(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, req.(*SeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_WatchSeats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSeatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicketServiceServer).WatchSeats(m, &grpc.GenericServerStream[WatchSeatsRequest, SeatEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicketService_WatchSeatsServer = grpc.ServerStreamingServer[SeatEvent]
+
+func _TicketService_SwapSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SwapSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SwapSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SwapSeats(ctx, req.(*SwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ChangeSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ChangeSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ChangeSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ChangeSeat(ctx, req.(*ChangeSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ticket.v1.TicketService",
+	HandlerType: (*TicketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurchaseTicket",
+			Handler:    _TicketService_PurchaseTicket_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _TicketService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "GetAllocatedUsers",
+			Handler:    _TicketService_GetAllocatedUsers_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _TicketService_RemoveUser_Handler,
+		},
+		{
+			MethodName: "ModifySeat",
+			Handler:    _TicketService_ModifySeat_Handler,
+		},
+		{
+			MethodName: "ListRoutes",
+			Handler:    _TicketService_ListRoutes_Handler,
+		},
+		{
+			MethodName: "ListDepartures",
+			Handler:    _TicketService_ListDepartures_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _TicketService_GetBooking_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _TicketService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "ModifyBooking",
+			Handler:    _TicketService_ModifyBooking_Handler,
+		},
+		{
+			MethodName: "ListMyBookings",
+			Handler:    _TicketService_ListMyBookings_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _TicketService_GetQuote_Handler,
+		},
+		{
+			MethodName: "HoldSeat",
+			Handler:    _TicketService_HoldSeat_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _TicketService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "PurchaseGroup",
+			Handler:    _TicketService_PurchaseGroup_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _TicketService_GetGroup_Handler,
+		},
+		{
+			MethodName: "CancelGroup",
+			Handler:    _TicketService_CancelGroup_Handler,
+		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _TicketService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "SwapSeats",
+			Handler:    _TicketService_SwapSeats_Handler,
+		},
+		{
+			MethodName: "ChangeSeat",
+			Handler:    _TicketService_ChangeSeat_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSeats",
+			Handler:       _TicketService_WatchSeats_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ticket/v1/ticket.proto",
+}
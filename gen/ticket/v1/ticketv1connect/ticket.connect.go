@@ -0,0 +1,685 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ticket/v1/ticket.proto
+
+package ticketv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TicketServiceName is the fully-qualified name of the TicketService service.
+	TicketServiceName = "ticket.v1.TicketService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TicketServicePurchaseTicketProcedure is the fully-qualified name of the TicketService's
+	// PurchaseTicket RPC.
+	TicketServicePurchaseTicketProcedure = "/ticket.v1.TicketService/PurchaseTicket"
+	// TicketServiceGetReceiptProcedure is the fully-qualified name of the TicketService's GetReceipt
+	// RPC.
+	TicketServiceGetReceiptProcedure = "/ticket.v1.TicketService/GetReceipt"
+	// TicketServiceGetAllocatedUsersProcedure is the fully-qualified name of the TicketService's
+	// GetAllocatedUsers RPC.
+	TicketServiceGetAllocatedUsersProcedure = "/ticket.v1.TicketService/GetAllocatedUsers"
+	// TicketServiceRemoveUserProcedure is the fully-qualified name of the TicketService's RemoveUser
+	// RPC.
+	TicketServiceRemoveUserProcedure = "/ticket.v1.TicketService/RemoveUser"
+	// TicketServiceModifySeatProcedure is the fully-qualified name of the TicketService's ModifySeat
+	// RPC.
+	TicketServiceModifySeatProcedure = "/ticket.v1.TicketService/ModifySeat"
+	// TicketServiceListRoutesProcedure is the fully-qualified name of the TicketService's ListRoutes
+	// RPC.
+	TicketServiceListRoutesProcedure = "/ticket.v1.TicketService/ListRoutes"
+	// TicketServiceListDeparturesProcedure is the fully-qualified name of the TicketService's
+	// ListDepartures RPC.
+	TicketServiceListDeparturesProcedure = "/ticket.v1.TicketService/ListDepartures"
+	// TicketServiceGetBookingProcedure is the fully-qualified name of the TicketService's GetBooking
+	// RPC.
+	TicketServiceGetBookingProcedure = "/ticket.v1.TicketService/GetBooking"
+	// TicketServiceCancelBookingProcedure is the fully-qualified name of the TicketService's
+	// CancelBooking RPC.
+	TicketServiceCancelBookingProcedure = "/ticket.v1.TicketService/CancelBooking"
+	// TicketServiceModifyBookingProcedure is the fully-qualified name of the TicketService's
+	// ModifyBooking RPC.
+	TicketServiceModifyBookingProcedure = "/ticket.v1.TicketService/ModifyBooking"
+	// TicketServiceListMyBookingsProcedure is the fully-qualified name of the TicketService's
+	// ListMyBookings RPC.
+	TicketServiceListMyBookingsProcedure = "/ticket.v1.TicketService/ListMyBookings"
+	// TicketServiceGetQuoteProcedure is the fully-qualified name of the TicketService's GetQuote RPC.
+	TicketServiceGetQuoteProcedure = "/ticket.v1.TicketService/GetQuote"
+	// TicketServiceHoldSeatProcedure is the fully-qualified name of the TicketService's HoldSeat RPC.
+	TicketServiceHoldSeatProcedure = "/ticket.v1.TicketService/HoldSeat"
+	// TicketServiceConfirmHoldProcedure is the fully-qualified name of the TicketService's ConfirmHold
+	// RPC.
+	TicketServiceConfirmHoldProcedure = "/ticket.v1.TicketService/ConfirmHold"
+	// TicketServicePurchaseGroupProcedure is the fully-qualified name of the TicketService's
+	// PurchaseGroup RPC.
+	TicketServicePurchaseGroupProcedure = "/ticket.v1.TicketService/PurchaseGroup"
+	// TicketServiceGetGroupProcedure is the fully-qualified name of the TicketService's GetGroup RPC.
+	TicketServiceGetGroupProcedure = "/ticket.v1.TicketService/GetGroup"
+	// TicketServiceCancelGroupProcedure is the fully-qualified name of the TicketService's CancelGroup
+	// RPC.
+	TicketServiceCancelGroupProcedure = "/ticket.v1.TicketService/CancelGroup"
+	// TicketServiceGetSeatMapProcedure is the fully-qualified name of the TicketService's GetSeatMap
+	// RPC.
+	TicketServiceGetSeatMapProcedure = "/ticket.v1.TicketService/GetSeatMap"
+	// TicketServiceWatchSeatsProcedure is the fully-qualified name of the TicketService's WatchSeats
+	// RPC.
+	TicketServiceWatchSeatsProcedure = "/ticket.v1.TicketService/WatchSeats"
+	// TicketServiceSwapSeatsProcedure is the fully-qualified name of the TicketService's SwapSeats RPC.
+	TicketServiceSwapSeatsProcedure = "/ticket.v1.TicketService/SwapSeats"
+	// TicketServiceChangeSeatProcedure is the fully-qualified name of the TicketService's ChangeSeat
+	// RPC.
+	TicketServiceChangeSeatProcedure = "/ticket.v1.TicketService/ChangeSeat"
+)
+
+// TicketServiceClient is a client for the ticket.v1.TicketService service.
+type TicketServiceClient interface {
+	PurchaseTicket(context.Context, *connect.Request[v1.PurchaseRequest]) (*connect.Response[v1.Receipt], error)
+	GetReceipt(context.Context, *connect.Request[v1.ReceiptRequest]) (*connect.Response[v1.Receipt], error)
+	GetAllocatedUsers(context.Context, *connect.Request[v1.SectionRequest]) (*connect.Response[v1.UserList], error)
+	RemoveUser(context.Context, *connect.Request[v1.RemoveRequest]) (*connect.Response[v1.Response], error)
+	ModifySeat(context.Context, *connect.Request[v1.ModifyRequest]) (*connect.Response[v1.Response], error)
+	ListRoutes(context.Context, *connect.Request[v1.RoutesRequest]) (*connect.Response[v1.RouteList], error)
+	ListDepartures(context.Context, *connect.Request[v1.DeparturesRequest]) (*connect.Response[v1.DepartureList], error)
+	GetBooking(context.Context, *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Receipt], error)
+	CancelBooking(context.Context, *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Response], error)
+	ModifyBooking(context.Context, *connect.Request[v1.ModifyBookingRequest]) (*connect.Response[v1.Response], error)
+	ListMyBookings(context.Context, *connect.Request[v1.MyBookingsRequest]) (*connect.Response[v1.ReceiptList], error)
+	GetQuote(context.Context, *connect.Request[v1.QuoteRequest]) (*connect.Response[v1.QuoteList], error)
+	HoldSeat(context.Context, *connect.Request[v1.HoldRequest]) (*connect.Response[v1.Receipt], error)
+	ConfirmHold(context.Context, *connect.Request[v1.ConfirmHoldRequest]) (*connect.Response[v1.Receipt], error)
+	PurchaseGroup(context.Context, *connect.Request[v1.GroupPurchaseRequest]) (*connect.Response[v1.GroupReceipt], error)
+	GetGroup(context.Context, *connect.Request[v1.GroupRequest]) (*connect.Response[v1.GroupReceipt], error)
+	CancelGroup(context.Context, *connect.Request[v1.GroupRequest]) (*connect.Response[v1.Response], error)
+	GetSeatMap(context.Context, *connect.Request[v1.SeatMapRequest]) (*connect.Response[v1.SeatMap], error)
+	WatchSeats(context.Context, *connect.Request[v1.WatchSeatsRequest]) (*connect.ServerStreamForClient[v1.SeatEvent], error)
+	SwapSeats(context.Context, *connect.Request[v1.SwapRequest]) (*connect.Response[v1.SwapResponse], error)
+	ChangeSeat(context.Context, *connect.Request[v1.ChangeSeatRequest]) (*connect.Response[v1.SeatChange], error)
+}
+
+// NewTicketServiceClient constructs a client for the ticket.v1.TicketService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTicketServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TicketServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ticketServiceMethods := v1.File_ticket_v1_ticket_proto.Services().ByName("TicketService").Methods()
+	return &ticketServiceClient{
+		purchaseTicket: connect.NewClient[v1.PurchaseRequest, v1.Receipt](
+			httpClient,
+			baseURL+TicketServicePurchaseTicketProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("PurchaseTicket")),
+			connect.WithClientOptions(opts...),
+		),
+		getReceipt: connect.NewClient[v1.ReceiptRequest, v1.Receipt](
+			httpClient,
+			baseURL+TicketServiceGetReceiptProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+		getAllocatedUsers: connect.NewClient[v1.SectionRequest, v1.UserList](
+			httpClient,
+			baseURL+TicketServiceGetAllocatedUsersProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetAllocatedUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		removeUser: connect.NewClient[v1.RemoveRequest, v1.Response](
+			httpClient,
+			baseURL+TicketServiceRemoveUserProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("RemoveUser")),
+			connect.WithClientOptions(opts...),
+		),
+		modifySeat: connect.NewClient[v1.ModifyRequest, v1.Response](
+			httpClient,
+			baseURL+TicketServiceModifySeatProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ModifySeat")),
+			connect.WithClientOptions(opts...),
+		),
+		listRoutes: connect.NewClient[v1.RoutesRequest, v1.RouteList](
+			httpClient,
+			baseURL+TicketServiceListRoutesProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ListRoutes")),
+			connect.WithClientOptions(opts...),
+		),
+		listDepartures: connect.NewClient[v1.DeparturesRequest, v1.DepartureList](
+			httpClient,
+			baseURL+TicketServiceListDeparturesProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ListDepartures")),
+			connect.WithClientOptions(opts...),
+		),
+		getBooking: connect.NewClient[v1.BookingRequest, v1.Receipt](
+			httpClient,
+			baseURL+TicketServiceGetBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelBooking: connect.NewClient[v1.BookingRequest, v1.Response](
+			httpClient,
+			baseURL+TicketServiceCancelBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("CancelBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		modifyBooking: connect.NewClient[v1.ModifyBookingRequest, v1.Response](
+			httpClient,
+			baseURL+TicketServiceModifyBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ModifyBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyBookings: connect.NewClient[v1.MyBookingsRequest, v1.ReceiptList](
+			httpClient,
+			baseURL+TicketServiceListMyBookingsProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ListMyBookings")),
+			connect.WithClientOptions(opts...),
+		),
+		getQuote: connect.NewClient[v1.QuoteRequest, v1.QuoteList](
+			httpClient,
+			baseURL+TicketServiceGetQuoteProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetQuote")),
+			connect.WithClientOptions(opts...),
+		),
+		holdSeat: connect.NewClient[v1.HoldRequest, v1.Receipt](
+			httpClient,
+			baseURL+TicketServiceHoldSeatProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("HoldSeat")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmHold: connect.NewClient[v1.ConfirmHoldRequest, v1.Receipt](
+			httpClient,
+			baseURL+TicketServiceConfirmHoldProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ConfirmHold")),
+			connect.WithClientOptions(opts...),
+		),
+		purchaseGroup: connect.NewClient[v1.GroupPurchaseRequest, v1.GroupReceipt](
+			httpClient,
+			baseURL+TicketServicePurchaseGroupProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("PurchaseGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		getGroup: connect.NewClient[v1.GroupRequest, v1.GroupReceipt](
+			httpClient,
+			baseURL+TicketServiceGetGroupProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelGroup: connect.NewClient[v1.GroupRequest, v1.Response](
+			httpClient,
+			baseURL+TicketServiceCancelGroupProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("CancelGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		getSeatMap: connect.NewClient[v1.SeatMapRequest, v1.SeatMap](
+			httpClient,
+			baseURL+TicketServiceGetSeatMapProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetSeatMap")),
+			connect.WithClientOptions(opts...),
+		),
+		watchSeats: connect.NewClient[v1.WatchSeatsRequest, v1.SeatEvent](
+			httpClient,
+			baseURL+TicketServiceWatchSeatsProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("WatchSeats")),
+			connect.WithClientOptions(opts...),
+		),
+		swapSeats: connect.NewClient[v1.SwapRequest, v1.SwapResponse](
+			httpClient,
+			baseURL+TicketServiceSwapSeatsProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("SwapSeats")),
+			connect.WithClientOptions(opts...),
+		),
+		changeSeat: connect.NewClient[v1.ChangeSeatRequest, v1.SeatChange](
+			httpClient,
+			baseURL+TicketServiceChangeSeatProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ChangeSeat")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ticketServiceClient implements TicketServiceClient.
+type ticketServiceClient struct {
+	purchaseTicket    *connect.Client[v1.PurchaseRequest, v1.Receipt]
+	getReceipt        *connect.Client[v1.ReceiptRequest, v1.Receipt]
+	getAllocatedUsers *connect.Client[v1.SectionRequest, v1.UserList]
+	removeUser        *connect.Client[v1.RemoveRequest, v1.Response]
+	modifySeat        *connect.Client[v1.ModifyRequest, v1.Response]
+	listRoutes        *connect.Client[v1.RoutesRequest, v1.RouteList]
+	listDepartures    *connect.Client[v1.DeparturesRequest, v1.DepartureList]
+	getBooking        *connect.Client[v1.BookingRequest, v1.Receipt]
+	cancelBooking     *connect.Client[v1.BookingRequest, v1.Response]
+	modifyBooking     *connect.Client[v1.ModifyBookingRequest, v1.Response]
+	listMyBookings    *connect.Client[v1.MyBookingsRequest, v1.ReceiptList]
+	getQuote          *connect.Client[v1.QuoteRequest, v1.QuoteList]
+	holdSeat          *connect.Client[v1.HoldRequest, v1.Receipt]
+	confirmHold       *connect.Client[v1.ConfirmHoldRequest, v1.Receipt]
+	purchaseGroup     *connect.Client[v1.GroupPurchaseRequest, v1.GroupReceipt]
+	getGroup          *connect.Client[v1.GroupRequest, v1.GroupReceipt]
+	cancelGroup       *connect.Client[v1.GroupRequest, v1.Response]
+	getSeatMap        *connect.Client[v1.SeatMapRequest, v1.SeatMap]
+	watchSeats        *connect.Client[v1.WatchSeatsRequest, v1.SeatEvent]
+	swapSeats         *connect.Client[v1.SwapRequest, v1.SwapResponse]
+	changeSeat        *connect.Client[v1.ChangeSeatRequest, v1.SeatChange]
+}
+
+// PurchaseTicket calls ticket.v1.TicketService.PurchaseTicket.
+func (c *ticketServiceClient) PurchaseTicket(ctx context.Context, req *connect.Request[v1.PurchaseRequest]) (*connect.Response[v1.Receipt], error) {
+	return c.purchaseTicket.CallUnary(ctx, req)
+}
+
+// GetReceipt calls ticket.v1.TicketService.GetReceipt.
+func (c *ticketServiceClient) GetReceipt(ctx context.Context, req *connect.Request[v1.ReceiptRequest]) (*connect.Response[v1.Receipt], error) {
+	return c.getReceipt.CallUnary(ctx, req)
+}
+
+// GetAllocatedUsers calls ticket.v1.TicketService.GetAllocatedUsers.
+func (c *ticketServiceClient) GetAllocatedUsers(ctx context.Context, req *connect.Request[v1.SectionRequest]) (*connect.Response[v1.UserList], error) {
+	return c.getAllocatedUsers.CallUnary(ctx, req)
+}
+
+// RemoveUser calls ticket.v1.TicketService.RemoveUser.
+func (c *ticketServiceClient) RemoveUser(ctx context.Context, req *connect.Request[v1.RemoveRequest]) (*connect.Response[v1.Response], error) {
+	return c.removeUser.CallUnary(ctx, req)
+}
+
+// ModifySeat calls ticket.v1.TicketService.ModifySeat.
+func (c *ticketServiceClient) ModifySeat(ctx context.Context, req *connect.Request[v1.ModifyRequest]) (*connect.Response[v1.Response], error) {
+	return c.modifySeat.CallUnary(ctx, req)
+}
+
+// ListRoutes calls ticket.v1.TicketService.ListRoutes.
+func (c *ticketServiceClient) ListRoutes(ctx context.Context, req *connect.Request[v1.RoutesRequest]) (*connect.Response[v1.RouteList], error) {
+	return c.listRoutes.CallUnary(ctx, req)
+}
+
+// ListDepartures calls ticket.v1.TicketService.ListDepartures.
+func (c *ticketServiceClient) ListDepartures(ctx context.Context, req *connect.Request[v1.DeparturesRequest]) (*connect.Response[v1.DepartureList], error) {
+	return c.listDepartures.CallUnary(ctx, req)
+}
+
+// GetBooking calls ticket.v1.TicketService.GetBooking.
+func (c *ticketServiceClient) GetBooking(ctx context.Context, req *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Receipt], error) {
+	return c.getBooking.CallUnary(ctx, req)
+}
+
+// CancelBooking calls ticket.v1.TicketService.CancelBooking.
+func (c *ticketServiceClient) CancelBooking(ctx context.Context, req *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Response], error) {
+	return c.cancelBooking.CallUnary(ctx, req)
+}
+
+// ModifyBooking calls ticket.v1.TicketService.ModifyBooking.
+func (c *ticketServiceClient) ModifyBooking(ctx context.Context, req *connect.Request[v1.ModifyBookingRequest]) (*connect.Response[v1.Response], error) {
+	return c.modifyBooking.CallUnary(ctx, req)
+}
+
+// ListMyBookings calls ticket.v1.TicketService.ListMyBookings.
+func (c *ticketServiceClient) ListMyBookings(ctx context.Context, req *connect.Request[v1.MyBookingsRequest]) (*connect.Response[v1.ReceiptList], error) {
+	return c.listMyBookings.CallUnary(ctx, req)
+}
+
+// GetQuote calls ticket.v1.TicketService.GetQuote.
+func (c *ticketServiceClient) GetQuote(ctx context.Context, req *connect.Request[v1.QuoteRequest]) (*connect.Response[v1.QuoteList], error) {
+	return c.getQuote.CallUnary(ctx, req)
+}
+
+// HoldSeat calls ticket.v1.TicketService.HoldSeat.
+func (c *ticketServiceClient) HoldSeat(ctx context.Context, req *connect.Request[v1.HoldRequest]) (*connect.Response[v1.Receipt], error) {
+	return c.holdSeat.CallUnary(ctx, req)
+}
+
+// ConfirmHold calls ticket.v1.TicketService.ConfirmHold.
+func (c *ticketServiceClient) ConfirmHold(ctx context.Context, req *connect.Request[v1.ConfirmHoldRequest]) (*connect.Response[v1.Receipt], error) {
+	return c.confirmHold.CallUnary(ctx, req)
+}
+
+// PurchaseGroup calls ticket.v1.TicketService.PurchaseGroup.
+func (c *ticketServiceClient) PurchaseGroup(ctx context.Context, req *connect.Request[v1.GroupPurchaseRequest]) (*connect.Response[v1.GroupReceipt], error) {
+	return c.purchaseGroup.CallUnary(ctx, req)
+}
+
+// GetGroup calls ticket.v1.TicketService.GetGroup.
+func (c *ticketServiceClient) GetGroup(ctx context.Context, req *connect.Request[v1.GroupRequest]) (*connect.Response[v1.GroupReceipt], error) {
+	return c.getGroup.CallUnary(ctx, req)
+}
+
+// CancelGroup calls ticket.v1.TicketService.CancelGroup.
+func (c *ticketServiceClient) CancelGroup(ctx context.Context, req *connect.Request[v1.GroupRequest]) (*connect.Response[v1.Response], error) {
+	return c.cancelGroup.CallUnary(ctx, req)
+}
+
+// GetSeatMap calls ticket.v1.TicketService.GetSeatMap.
+func (c *ticketServiceClient) GetSeatMap(ctx context.Context, req *connect.Request[v1.SeatMapRequest]) (*connect.Response[v1.SeatMap], error) {
+	return c.getSeatMap.CallUnary(ctx, req)
+}
+
+// WatchSeats calls ticket.v1.TicketService.WatchSeats.
+func (c *ticketServiceClient) WatchSeats(ctx context.Context, req *connect.Request[v1.WatchSeatsRequest]) (*connect.ServerStreamForClient[v1.SeatEvent], error) {
+	return c.watchSeats.CallServerStream(ctx, req)
+}
+
+// SwapSeats calls ticket.v1.TicketService.SwapSeats.
+func (c *ticketServiceClient) SwapSeats(ctx context.Context, req *connect.Request[v1.SwapRequest]) (*connect.Response[v1.SwapResponse], error) {
+	return c.swapSeats.CallUnary(ctx, req)
+}
+
+// ChangeSeat calls ticket.v1.TicketService.ChangeSeat.
+func (c *ticketServiceClient) ChangeSeat(ctx context.Context, req *connect.Request[v1.ChangeSeatRequest]) (*connect.Response[v1.SeatChange], error) {
+	return c.changeSeat.CallUnary(ctx, req)
+}
+
+// TicketServiceHandler is an implementation of the ticket.v1.TicketService service.
+type TicketServiceHandler interface {
+	PurchaseTicket(context.Context, *connect.Request[v1.PurchaseRequest]) (*connect.Response[v1.Receipt], error)
+	GetReceipt(context.Context, *connect.Request[v1.ReceiptRequest]) (*connect.Response[v1.Receipt], error)
+	GetAllocatedUsers(context.Context, *connect.Request[v1.SectionRequest]) (*connect.Response[v1.UserList], error)
+	RemoveUser(context.Context, *connect.Request[v1.RemoveRequest]) (*connect.Response[v1.Response], error)
+	ModifySeat(context.Context, *connect.Request[v1.ModifyRequest]) (*connect.Response[v1.Response], error)
+	ListRoutes(context.Context, *connect.Request[v1.RoutesRequest]) (*connect.Response[v1.RouteList], error)
+	ListDepartures(context.Context, *connect.Request[v1.DeparturesRequest]) (*connect.Response[v1.DepartureList], error)
+	GetBooking(context.Context, *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Receipt], error)
+	CancelBooking(context.Context, *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Response], error)
+	ModifyBooking(context.Context, *connect.Request[v1.ModifyBookingRequest]) (*connect.Response[v1.Response], error)
+	ListMyBookings(context.Context, *connect.Request[v1.MyBookingsRequest]) (*connect.Response[v1.ReceiptList], error)
+	GetQuote(context.Context, *connect.Request[v1.QuoteRequest]) (*connect.Response[v1.QuoteList], error)
+	HoldSeat(context.Context, *connect.Request[v1.HoldRequest]) (*connect.Response[v1.Receipt], error)
+	ConfirmHold(context.Context, *connect.Request[v1.ConfirmHoldRequest]) (*connect.Response[v1.Receipt], error)
+	PurchaseGroup(context.Context, *connect.Request[v1.GroupPurchaseRequest]) (*connect.Response[v1.GroupReceipt], error)
+	GetGroup(context.Context, *connect.Request[v1.GroupRequest]) (*connect.Response[v1.GroupReceipt], error)
+	CancelGroup(context.Context, *connect.Request[v1.GroupRequest]) (*connect.Response[v1.Response], error)
+	GetSeatMap(context.Context, *connect.Request[v1.SeatMapRequest]) (*connect.Response[v1.SeatMap], error)
+	WatchSeats(context.Context, *connect.Request[v1.WatchSeatsRequest], *connect.ServerStream[v1.SeatEvent]) error
+	SwapSeats(context.Context, *connect.Request[v1.SwapRequest]) (*connect.Response[v1.SwapResponse], error)
+	ChangeSeat(context.Context, *connect.Request[v1.ChangeSeatRequest]) (*connect.Response[v1.SeatChange], error)
+}
+
+// NewTicketServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTicketServiceHandler(svc TicketServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ticketServiceMethods := v1.File_ticket_v1_ticket_proto.Services().ByName("TicketService").Methods()
+	ticketServicePurchaseTicketHandler := connect.NewUnaryHandler(
+		TicketServicePurchaseTicketProcedure,
+		svc.PurchaseTicket,
+		connect.WithSchema(ticketServiceMethods.ByName("PurchaseTicket")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetReceiptHandler := connect.NewUnaryHandler(
+		TicketServiceGetReceiptProcedure,
+		svc.GetReceipt,
+		connect.WithSchema(ticketServiceMethods.ByName("GetReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetAllocatedUsersHandler := connect.NewUnaryHandler(
+		TicketServiceGetAllocatedUsersProcedure,
+		svc.GetAllocatedUsers,
+		connect.WithSchema(ticketServiceMethods.ByName("GetAllocatedUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceRemoveUserHandler := connect.NewUnaryHandler(
+		TicketServiceRemoveUserProcedure,
+		svc.RemoveUser,
+		connect.WithSchema(ticketServiceMethods.ByName("RemoveUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceModifySeatHandler := connect.NewUnaryHandler(
+		TicketServiceModifySeatProcedure,
+		svc.ModifySeat,
+		connect.WithSchema(ticketServiceMethods.ByName("ModifySeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceListRoutesHandler := connect.NewUnaryHandler(
+		TicketServiceListRoutesProcedure,
+		svc.ListRoutes,
+		connect.WithSchema(ticketServiceMethods.ByName("ListRoutes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceListDeparturesHandler := connect.NewUnaryHandler(
+		TicketServiceListDeparturesProcedure,
+		svc.ListDepartures,
+		connect.WithSchema(ticketServiceMethods.ByName("ListDepartures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetBookingHandler := connect.NewUnaryHandler(
+		TicketServiceGetBookingProcedure,
+		svc.GetBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("GetBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceCancelBookingHandler := connect.NewUnaryHandler(
+		TicketServiceCancelBookingProcedure,
+		svc.CancelBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("CancelBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceModifyBookingHandler := connect.NewUnaryHandler(
+		TicketServiceModifyBookingProcedure,
+		svc.ModifyBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("ModifyBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceListMyBookingsHandler := connect.NewUnaryHandler(
+		TicketServiceListMyBookingsProcedure,
+		svc.ListMyBookings,
+		connect.WithSchema(ticketServiceMethods.ByName("ListMyBookings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetQuoteHandler := connect.NewUnaryHandler(
+		TicketServiceGetQuoteProcedure,
+		svc.GetQuote,
+		connect.WithSchema(ticketServiceMethods.ByName("GetQuote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceHoldSeatHandler := connect.NewUnaryHandler(
+		TicketServiceHoldSeatProcedure,
+		svc.HoldSeat,
+		connect.WithSchema(ticketServiceMethods.ByName("HoldSeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceConfirmHoldHandler := connect.NewUnaryHandler(
+		TicketServiceConfirmHoldProcedure,
+		svc.ConfirmHold,
+		connect.WithSchema(ticketServiceMethods.ByName("ConfirmHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServicePurchaseGroupHandler := connect.NewUnaryHandler(
+		TicketServicePurchaseGroupProcedure,
+		svc.PurchaseGroup,
+		connect.WithSchema(ticketServiceMethods.ByName("PurchaseGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetGroupHandler := connect.NewUnaryHandler(
+		TicketServiceGetGroupProcedure,
+		svc.GetGroup,
+		connect.WithSchema(ticketServiceMethods.ByName("GetGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceCancelGroupHandler := connect.NewUnaryHandler(
+		TicketServiceCancelGroupProcedure,
+		svc.CancelGroup,
+		connect.WithSchema(ticketServiceMethods.ByName("CancelGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetSeatMapHandler := connect.NewUnaryHandler(
+		TicketServiceGetSeatMapProcedure,
+		svc.GetSeatMap,
+		connect.WithSchema(ticketServiceMethods.ByName("GetSeatMap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceWatchSeatsHandler := connect.NewServerStreamHandler(
+		TicketServiceWatchSeatsProcedure,
+		svc.WatchSeats,
+		connect.WithSchema(ticketServiceMethods.ByName("WatchSeats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceSwapSeatsHandler := connect.NewUnaryHandler(
+		TicketServiceSwapSeatsProcedure,
+		svc.SwapSeats,
+		connect.WithSchema(ticketServiceMethods.ByName("SwapSeats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceChangeSeatHandler := connect.NewUnaryHandler(
+		TicketServiceChangeSeatProcedure,
+		svc.ChangeSeat,
+		connect.WithSchema(ticketServiceMethods.ByName("ChangeSeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ticket.v1.TicketService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TicketServicePurchaseTicketProcedure:
+			ticketServicePurchaseTicketHandler.ServeHTTP(w, r)
+		case TicketServiceGetReceiptProcedure:
+			ticketServiceGetReceiptHandler.ServeHTTP(w, r)
+		case TicketServiceGetAllocatedUsersProcedure:
+			ticketServiceGetAllocatedUsersHandler.ServeHTTP(w, r)
+		case TicketServiceRemoveUserProcedure:
+			ticketServiceRemoveUserHandler.ServeHTTP(w, r)
+		case TicketServiceModifySeatProcedure:
+			ticketServiceModifySeatHandler.ServeHTTP(w, r)
+		case TicketServiceListRoutesProcedure:
+			ticketServiceListRoutesHandler.ServeHTTP(w, r)
+		case TicketServiceListDeparturesProcedure:
+			ticketServiceListDeparturesHandler.ServeHTTP(w, r)
+		case TicketServiceGetBookingProcedure:
+			ticketServiceGetBookingHandler.ServeHTTP(w, r)
+		case TicketServiceCancelBookingProcedure:
+			ticketServiceCancelBookingHandler.ServeHTTP(w, r)
+		case TicketServiceModifyBookingProcedure:
+			ticketServiceModifyBookingHandler.ServeHTTP(w, r)
+		case TicketServiceListMyBookingsProcedure:
+			ticketServiceListMyBookingsHandler.ServeHTTP(w, r)
+		case TicketServiceGetQuoteProcedure:
+			ticketServiceGetQuoteHandler.ServeHTTP(w, r)
+		case TicketServiceHoldSeatProcedure:
+			ticketServiceHoldSeatHandler.ServeHTTP(w, r)
+		case TicketServiceConfirmHoldProcedure:
+			ticketServiceConfirmHoldHandler.ServeHTTP(w, r)
+		case TicketServicePurchaseGroupProcedure:
+			ticketServicePurchaseGroupHandler.ServeHTTP(w, r)
+		case TicketServiceGetGroupProcedure:
+			ticketServiceGetGroupHandler.ServeHTTP(w, r)
+		case TicketServiceCancelGroupProcedure:
+			ticketServiceCancelGroupHandler.ServeHTTP(w, r)
+		case TicketServiceGetSeatMapProcedure:
+			ticketServiceGetSeatMapHandler.ServeHTTP(w, r)
+		case TicketServiceWatchSeatsProcedure:
+			ticketServiceWatchSeatsHandler.ServeHTTP(w, r)
+		case TicketServiceSwapSeatsProcedure:
+			ticketServiceSwapSeatsHandler.ServeHTTP(w, r)
+		case TicketServiceChangeSeatProcedure:
+			ticketServiceChangeSeatHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTicketServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTicketServiceHandler struct{}
+
+func (UnimplementedTicketServiceHandler) PurchaseTicket(context.Context, *connect.Request[v1.PurchaseRequest]) (*connect.Response[v1.Receipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.PurchaseTicket is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetReceipt(context.Context, *connect.Request[v1.ReceiptRequest]) (*connect.Response[v1.Receipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.GetReceipt is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetAllocatedUsers(context.Context, *connect.Request[v1.SectionRequest]) (*connect.Response[v1.UserList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.GetAllocatedUsers is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) RemoveUser(context.Context, *connect.Request[v1.RemoveRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.RemoveUser is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ModifySeat(context.Context, *connect.Request[v1.ModifyRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ModifySeat is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ListRoutes(context.Context, *connect.Request[v1.RoutesRequest]) (*connect.Response[v1.RouteList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ListRoutes is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ListDepartures(context.Context, *connect.Request[v1.DeparturesRequest]) (*connect.Response[v1.DepartureList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ListDepartures is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetBooking(context.Context, *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Receipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.GetBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) CancelBooking(context.Context, *connect.Request[v1.BookingRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.CancelBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ModifyBooking(context.Context, *connect.Request[v1.ModifyBookingRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ModifyBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ListMyBookings(context.Context, *connect.Request[v1.MyBookingsRequest]) (*connect.Response[v1.ReceiptList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ListMyBookings is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetQuote(context.Context, *connect.Request[v1.QuoteRequest]) (*connect.Response[v1.QuoteList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.GetQuote is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) HoldSeat(context.Context, *connect.Request[v1.HoldRequest]) (*connect.Response[v1.Receipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.HoldSeat is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ConfirmHold(context.Context, *connect.Request[v1.ConfirmHoldRequest]) (*connect.Response[v1.Receipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ConfirmHold is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) PurchaseGroup(context.Context, *connect.Request[v1.GroupPurchaseRequest]) (*connect.Response[v1.GroupReceipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.PurchaseGroup is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetGroup(context.Context, *connect.Request[v1.GroupRequest]) (*connect.Response[v1.GroupReceipt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.GetGroup is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) CancelGroup(context.Context, *connect.Request[v1.GroupRequest]) (*connect.Response[v1.Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.CancelGroup is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetSeatMap(context.Context, *connect.Request[v1.SeatMapRequest]) (*connect.Response[v1.SeatMap], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.GetSeatMap is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) WatchSeats(context.Context, *connect.Request[v1.WatchSeatsRequest], *connect.ServerStream[v1.SeatEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.WatchSeats is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) SwapSeats(context.Context, *connect.Request[v1.SwapRequest]) (*connect.Response[v1.SwapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.SwapSeats is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ChangeSeat(context.Context, *connect.Request[v1.ChangeSeatRequest]) (*connect.Response[v1.SeatChange], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v1.TicketService.ChangeSeat is not implemented"))
+}
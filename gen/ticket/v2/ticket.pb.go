@@ -0,0 +1,3908 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: ticket/v2/ticket.proto
+
+package ticketv2
+
+import (
+	_ "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BookingStatus int32
+
+const (
+	BookingStatus_BOOKING_STATUS_UNSPECIFIED BookingStatus = 0
+	BookingStatus_BOOKING_STATUS_CONFIRMED   BookingStatus = 1
+	BookingStatus_BOOKING_STATUS_HELD        BookingStatus = 2 // Seat reserved until hold_expires_at, awaiting ConfirmHold
+	BookingStatus_BOOKING_STATUS_CANCELLED   BookingStatus = 3 // Kept for the booking history; the seat is free again
+	BookingStatus_BOOKING_STATUS_WAITLISTED  BookingStatus = 4 // No seat yet; held for the passenger when one frees up
+)
+
+// Enum value maps for BookingStatus.
+var (
+	BookingStatus_name = map[int32]string{
+		0: "BOOKING_STATUS_UNSPECIFIED",
+		1: "BOOKING_STATUS_CONFIRMED",
+		2: "BOOKING_STATUS_HELD",
+		3: "BOOKING_STATUS_CANCELLED",
+		4: "BOOKING_STATUS_WAITLISTED",
+	}
+	BookingStatus_value = map[string]int32{
+		"BOOKING_STATUS_UNSPECIFIED": 0,
+		"BOOKING_STATUS_CONFIRMED":   1,
+		"BOOKING_STATUS_HELD":        2,
+		"BOOKING_STATUS_CANCELLED":   3,
+		"BOOKING_STATUS_WAITLISTED":  4,
+	}
+)
+
+func (x BookingStatus) Enum() *BookingStatus {
+	p := new(BookingStatus)
+	*p = x
+	return p
+}
+
+func (x BookingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v2_ticket_proto_enumTypes[0].Descriptor()
+}
+
+func (BookingStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v2_ticket_proto_enumTypes[0]
+}
+
+func (x BookingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingStatus.Descriptor instead.
+func (BookingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{0}
+}
+
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED        PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED         PaymentStatus = 1 // Amount reserved, not yet collected
+	PaymentStatus_PAYMENT_STATUS_CAPTURED           PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_REFUNDED           PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED PaymentStatus = 4
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_AUTHORIZED",
+		2: "PAYMENT_STATUS_CAPTURED",
+		3: "PAYMENT_STATUS_REFUNDED",
+		4: "PAYMENT_STATUS_PARTIALLY_REFUNDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED":        0,
+		"PAYMENT_STATUS_AUTHORIZED":         1,
+		"PAYMENT_STATUS_CAPTURED":           2,
+		"PAYMENT_STATUS_REFUNDED":           3,
+		"PAYMENT_STATUS_PARTIALLY_REFUNDED": 4,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v2_ticket_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v2_ticket_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{1}
+}
+
+type SeatPosition int32
+
+const (
+	SeatPosition_SEAT_POSITION_UNSPECIFIED SeatPosition = 0 // Any seat
+	SeatPosition_SEAT_POSITION_WINDOW      SeatPosition = 1 // First or last seat of a row
+	SeatPosition_SEAT_POSITION_AISLE       SeatPosition = 2 // Next to the aisle down the middle of the row
+)
+
+// Enum value maps for SeatPosition.
+var (
+	SeatPosition_name = map[int32]string{
+		0: "SEAT_POSITION_UNSPECIFIED",
+		1: "SEAT_POSITION_WINDOW",
+		2: "SEAT_POSITION_AISLE",
+	}
+	SeatPosition_value = map[string]int32{
+		"SEAT_POSITION_UNSPECIFIED": 0,
+		"SEAT_POSITION_WINDOW":      1,
+		"SEAT_POSITION_AISLE":       2,
+	}
+)
+
+func (x SeatPosition) Enum() *SeatPosition {
+	p := new(SeatPosition)
+	*p = x
+	return p
+}
+
+func (x SeatPosition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatPosition) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v2_ticket_proto_enumTypes[2].Descriptor()
+}
+
+func (SeatPosition) Type() protoreflect.EnumType {
+	return &file_ticket_v2_ticket_proto_enumTypes[2]
+}
+
+func (x SeatPosition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatPosition.Descriptor instead.
+func (SeatPosition) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{2}
+}
+
+type SeatStatus int32
+
+const (
+	SeatStatus_SEAT_STATUS_UNSPECIFIED SeatStatus = 0
+	SeatStatus_SEAT_STATUS_FREE        SeatStatus = 1
+	SeatStatus_SEAT_STATUS_HELD        SeatStatus = 2 // Held for a customer, may become free again
+	SeatStatus_SEAT_STATUS_BOOKED      SeatStatus = 3
+	SeatStatus_SEAT_STATUS_BLOCKED     SeatStatus = 4 // Not on sale
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "SEAT_STATUS_UNSPECIFIED",
+		1: "SEAT_STATUS_FREE",
+		2: "SEAT_STATUS_HELD",
+		3: "SEAT_STATUS_BOOKED",
+		4: "SEAT_STATUS_BLOCKED",
+	}
+	SeatStatus_value = map[string]int32{
+		"SEAT_STATUS_UNSPECIFIED": 0,
+		"SEAT_STATUS_FREE":        1,
+		"SEAT_STATUS_HELD":        2,
+		"SEAT_STATUS_BOOKED":      3,
+		"SEAT_STATUS_BLOCKED":     4,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_v2_ticket_proto_enumTypes[3].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_ticket_v2_ticket_proto_enumTypes[3]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{3}
+}
+
+// An exact amount of money, laid out like google.type.Money
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217
+	Units        int64  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`                                  // Whole units of the currency
+	Nanos        int32  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`                                  // Billionths of a unit, 0 to 999,999,999
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+type Passenger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *Passenger) Reset() {
+	*x = Passenger{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passenger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passenger) ProtoMessage() {}
+
+func (x *Passenger) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passenger.ProtoReflect.Descriptor instead.
+func (*Passenger) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Passenger) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Passenger) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Passenger) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Payment method issued by the payment provider, e.g. a tokenised card
+type PaymentMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PaymentMethod) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Booking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	DepartureId   string                 `protobuf:"bytes,2,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	DepartsAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departs_at,json=departsAt,proto3" json:"departs_at,omitempty"`
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Passenger     *Passenger             `protobuf:"bytes,6,opt,name=passenger,proto3" json:"passenger,omitempty"`
+	Seat          string                 `protobuf:"bytes,7,opt,name=seat,proto3" json:"seat,omitempty"` // Empty while waitlisted
+	TravelClass   string                 `protobuf:"bytes,8,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Price         *Money                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"` // Unset while waitlisted
+	Status        BookingStatus          `protobuf:"varint,10,opt,name=status,proto3,enum=ticket.v2.BookingStatus" json:"status,omitempty"`
+	HoldExpiresAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"` // Set while held
+	WaitlistedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=waitlisted_at,json=waitlistedAt,proto3" json:"waitlisted_at,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	Payment       *Payment               `protobuf:"bytes,14,opt,name=payment,proto3" json:"payment,omitempty"`                      // Unset until the booking is paid for
+	GroupId       string                 `protobuf:"bytes,15,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`       // Set for bookings of a group booking
+	SwapOffer     *SwapOffer             `protobuf:"bytes,16,opt,name=swap_offer,json=swapOffer,proto3" json:"swap_offer,omitempty"` // Set while the passenger offers to swap seats
+	Preference    *SeatPreference        `protobuf:"bytes,17,opt,name=preference,proto3" json:"preference,omitempty"`                // Where a waitlisted booking wants to sit; honoured when a seat is allocated to it
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Booking) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Booking) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *Booking) GetDepartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartsAt
+	}
+	return nil
+}
+
+func (x *Booking) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Booking) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Booking) GetPassenger() *Passenger {
+	if x != nil {
+		return x.Passenger
+	}
+	return nil
+}
+
+func (x *Booking) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *Booking) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *Booking) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Booking) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *Booking) GetHoldExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Booking) GetWaitlistedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WaitlistedAt
+	}
+	return nil
+}
+
+func (x *Booking) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Booking) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Booking) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Booking) GetSwapOffer() *SwapOffer {
+	if x != nil {
+		return x.SwapOffer
+	}
+	return nil
+}
+
+func (x *Booking) GetPreference() *SeatPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status          PaymentStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=ticket.v2.PaymentStatus" json:"status,omitempty"`
+	TransactionId   string            `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`       // Reference at the payment provider
+	Refunded        *Money            `protobuf:"bytes,3,opt,name=refunded,proto3" json:"refunded,omitempty"`                                      // Paid back on cancellation
+	FareAdjustments []*FareAdjustment `protobuf:"bytes,4,rep,name=fare_adjustments,json=fareAdjustments,proto3" json:"fare_adjustments,omitempty"` // Differences paid on changes to dearer seats
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Payment) GetRefunded() *Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *Payment) GetFareAdjustments() []*FareAdjustment {
+	if x != nil {
+		return x.FareAdjustments
+	}
+	return nil
+}
+
+// A fare difference paid when a booking moved to a dearer seat
+type FareAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Refunded      *Money `protobuf:"bytes,3,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (x *FareAdjustment) Reset() {
+	*x = FareAdjustment{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FareAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareAdjustment) ProtoMessage() {}
+
+func (x *FareAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareAdjustment.ProtoReflect.Descriptor instead.
+func (*FareAdjustment) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FareAdjustment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *FareAdjustment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FareAdjustment) GetRefunded() *Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+// The seat a passenger has offered to trade for their own
+type SwapOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Seat      string `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+}
+
+func (x *SwapOffer) Reset() {
+	*x = SwapOffer{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapOffer) ProtoMessage() {}
+
+func (x *SwapOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapOffer.ProtoReflect.Descriptor instead.
+func (*SwapOffer) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SwapOffer) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *SwapOffer) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+// Where a passenger would like to sit. section and seat must be met; the
+// others are honoured when a matching seat is free.
+type SeatPreference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section       string       `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Seat          string       `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	Position      SeatPosition `protobuf:"varint,3,opt,name=position,proto3,enum=ticket.v2.SeatPosition" json:"position,omitempty"`
+	NearBookingId string       `protobuf:"bytes,4,opt,name=near_booking_id,json=nearBookingId,proto3" json:"near_booking_id,omitempty"` // Sit as close as possible to this booking
+}
+
+func (x *SeatPreference) Reset() {
+	*x = SeatPreference{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatPreference) ProtoMessage() {}
+
+func (x *SeatPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatPreference.ProtoReflect.Descriptor instead.
+func (*SeatPreference) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SeatPreference) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SeatPreference) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *SeatPreference) GetPosition() SeatPosition {
+	if x != nil {
+		return x.Position
+	}
+	return SeatPosition_SEAT_POSITION_UNSPECIFIED
+}
+
+func (x *SeatPreference) GetNearBookingId() string {
+	if x != nil {
+		return x.NearBookingId
+	}
+	return ""
+}
+
+type Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId  string   `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	Name     string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Stations []string `protobuf:"bytes,3,rep,name=stations,proto3" json:"stations,omitempty"` // In travel order
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Route) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Route) GetStations() []string {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type ListRoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRoutesRequest) Reset() {
+	*x = ListRoutesRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesRequest) ProtoMessage() {}
+
+func (x *ListRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{9}
+}
+
+type ListRoutesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *ListRoutesResponse) Reset() {
+	*x = ListRoutesResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesResponse) ProtoMessage() {}
+
+func (x *ListRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesResponse.ProtoReflect.Descriptor instead.
+func (*ListRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRoutesResponse) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type Departure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId    string                 `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	RouteId        string                 `protobuf:"bytes,2,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	DepartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departs_at,json=departsAt,proto3" json:"departs_at,omitempty"`
+	SeatsAvailable int32                  `protobuf:"varint,4,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"` // For the requested journey
+}
+
+func (x *Departure) Reset() {
+	*x = Departure{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Departure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Departure) ProtoMessage() {}
+
+func (x *Departure) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Departure.ProtoReflect.Descriptor instead.
+func (*Departure) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Departure) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *Departure) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *Departure) GetDepartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartsAt
+	}
+	return nil
+}
+
+func (x *Departure) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+// All fields are optional filters
+type ListDeparturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId string `protobuf:"bytes,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Date    string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, in UTC
+}
+
+func (x *ListDeparturesRequest) Reset() {
+	*x = ListDeparturesRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeparturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeparturesRequest) ProtoMessage() {}
+
+func (x *ListDeparturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeparturesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeparturesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListDeparturesRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *ListDeparturesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListDeparturesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListDeparturesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ListDeparturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departures []*Departure `protobuf:"bytes,1,rep,name=departures,proto3" json:"departures,omitempty"`
+}
+
+func (x *ListDeparturesResponse) Reset() {
+	*x = ListDeparturesResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeparturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeparturesResponse) ProtoMessage() {}
+
+func (x *ListDeparturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeparturesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeparturesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDeparturesResponse) GetDepartures() []*Departure {
+	if x != nil {
+		return x.Departures
+	}
+	return nil
+}
+
+type GetQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // The whole route when from and to are empty
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TravelClass string `protobuf:"bytes,4,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"` // Optional filter
+	Section     string `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`                            // Optional filter
+}
+
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteRequest) ProtoMessage() {}
+
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetQuoteRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+// Price of the journey in one section
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section        string `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	TravelClass    string `protobuf:"bytes,2,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Fare           *Money `protobuf:"bytes,3,opt,name=fare,proto3" json:"fare,omitempty"`
+	SeatsAvailable int32  `protobuf:"varint,4,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"`
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Quote) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Quote) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *Quote) GetFare() *Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+func (x *Quote) GetSeatsAvailable() int32 {
+	if x != nil {
+		return x.SeatsAvailable
+	}
+	return 0
+}
+
+type GetQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string   `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Quotes      []*Quote `protobuf:"bytes,4,rep,name=quotes,proto3" json:"quotes,omitempty"`
+}
+
+func (x *GetQuoteResponse) Reset() {
+	*x = GetQuoteResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteResponse) ProtoMessage() {}
+
+func (x *GetQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetQuoteResponse) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetQuoteResponse) GetQuotes() []*Quote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type GetSeatMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // Seat status is for this journey; the whole route when empty
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetSeatMapRequest) Reset() {
+	*x = GetSeatMapRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapRequest) ProtoMessage() {}
+
+func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSeatMapRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetSeatMapRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Every seat of a departure and whether it can be booked, without the
+// passengers in them
+type GetSeatMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string        `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Sections    []*SectionMap `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	Cursor      string        `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"` // Pass to WatchSeats to follow changes from this map on
+}
+
+func (x *GetSeatMapResponse) Reset() {
+	*x = GetSeatMapResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapResponse) ProtoMessage() {}
+
+func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetSeatMapResponse) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetSections() []*SectionMap {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *GetSeatMapResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SectionMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TravelClass string      `protobuf:"bytes,2,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Rows        int32       `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	SeatsPerRow int32       `protobuf:"varint,4,opt,name=seats_per_row,json=seatsPerRow,proto3" json:"seats_per_row,omitempty"`
+	Fare        *Money      `protobuf:"bytes,5,opt,name=fare,proto3" json:"fare,omitempty"` // Price of the journey in this section
+	Seats       []*SeatInfo `protobuf:"bytes,6,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *SectionMap) Reset() {
+	*x = SectionMap{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionMap) ProtoMessage() {}
+
+func (x *SectionMap) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionMap.ProtoReflect.Descriptor instead.
+func (*SectionMap) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SectionMap) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SectionMap) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *SectionMap) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SectionMap) GetSeatsPerRow() int32 {
+	if x != nil {
+		return x.SeatsPerRow
+	}
+	return 0
+}
+
+func (x *SectionMap) GetFare() *Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+func (x *SectionMap) GetSeats() []*SeatInfo {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type SeatInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string     `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Row    int32      `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`       // 1-based
+	Column int32      `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"` // 0-based position within the row
+	Window bool       `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	Aisle  bool       `protobuf:"varint,5,opt,name=aisle,proto3" json:"aisle,omitempty"`
+	Status SeatStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ticket.v2.SeatStatus" json:"status,omitempty"`
+}
+
+func (x *SeatInfo) Reset() {
+	*x = SeatInfo{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatInfo) ProtoMessage() {}
+
+func (x *SeatInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatInfo.ProtoReflect.Descriptor instead.
+func (*SeatInfo) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SeatInfo) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SeatInfo) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatInfo) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatInfo) GetWindow() bool {
+	if x != nil {
+		return x.Window
+	}
+	return false
+}
+
+func (x *SeatInfo) GetAisle() bool {
+	if x != nil {
+		return x.Aisle
+	}
+	return false
+}
+
+func (x *SeatInfo) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+type WatchSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	Cursor      string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // Resume after this event; only new changes when empty
+}
+
+func (x *WatchSeatsRequest) Reset() {
+	*x = WatchSeatsRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSeatsRequest) ProtoMessage() {}
+
+func (x *WatchSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSeatsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchSeatsRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *WatchSeatsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// A change of the status of a seat on part of the route
+type WatchSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor      string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // Pass to WatchSeats to resume after this event
+	DepartureId string                 `protobuf:"bytes,2,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	Seat        string                 `protobuf:"bytes,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	Section     string                 `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	From        string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"` // The stretch of the route the change applies to
+	To          string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Status      SeatStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=ticket.v2.SeatStatus" json:"status,omitempty"` // New status of the seat from from to to
+	Time        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *WatchSeatsResponse) Reset() {
+	*x = WatchSeatsResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSeatsResponse) ProtoMessage() {}
+
+func (x *WatchSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSeatsResponse.ProtoReflect.Descriptor instead.
+func (*WatchSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WatchSeatsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchSeatsResponse) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *WatchSeatsResponse) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *WatchSeatsResponse) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *WatchSeatsResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WatchSeatsResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *WatchSeatsResponse) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *WatchSeatsResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId  string          `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From         string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // The whole route when from and to are empty
+	To           string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TravelClass  string          `protobuf:"bytes,4,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"` // Any class when empty
+	Passenger    *Passenger      `protobuf:"bytes,5,opt,name=passenger,proto3" json:"passenger,omitempty"`
+	Preference   *SeatPreference `protobuf:"bytes,6,opt,name=preference,proto3" json:"preference,omitempty"`
+	Payment      *PaymentMethod  `protobuf:"bytes,7,opt,name=payment,proto3" json:"payment,omitempty"`
+	Price        *Money          `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`                                    // Optional; when set it must match the fare
+	JoinWaitlist bool            `protobuf:"varint,9,opt,name=join_waitlist,json=joinWaitlist,proto3" json:"join_waitlist,omitempty"` // Queue for a seat instead of failing when the train is full
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateBookingRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPassenger() *Passenger {
+	if x != nil {
+		return x.Passenger
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetPreference() *SeatPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetJoinWaitlist() bool {
+	if x != nil {
+		return x.JoinWaitlist
+	}
+	return false
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type HoldSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string          `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TravelClass string          `protobuf:"bytes,4,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Passenger   *Passenger      `protobuf:"bytes,5,opt,name=passenger,proto3" json:"passenger,omitempty"`
+	Preference  *SeatPreference `protobuf:"bytes,6,opt,name=preference,proto3" json:"preference,omitempty"`
+}
+
+func (x *HoldSeatRequest) Reset() {
+	*x = HoldSeatRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatRequest) ProtoMessage() {}
+
+func (x *HoldSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatRequest.ProtoReflect.Descriptor instead.
+func (*HoldSeatRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HoldSeatRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetPassenger() *Passenger {
+	if x != nil {
+		return x.Passenger
+	}
+	return nil
+}
+
+func (x *HoldSeatRequest) GetPreference() *SeatPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type HoldSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *HoldSeatResponse) Reset() {
+	*x = HoldSeatResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatResponse) ProtoMessage() {}
+
+func (x *HoldSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatResponse.ProtoReflect.Descriptor instead.
+func (*HoldSeatResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HoldSeatResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ConfirmHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string         `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Payment   *PaymentMethod `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+	Price     *Money         `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"` // Optional; when set it must match the held fare
+}
+
+func (x *ConfirmHoldRequest) Reset() {
+	*x = ConfirmHoldRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldRequest) ProtoMessage() {}
+
+func (x *ConfirmHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConfirmHoldRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ConfirmHoldRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *ConfirmHoldRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ConfirmHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *ConfirmHoldResponse) Reset() {
+	*x = ConfirmHoldResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldResponse) ProtoMessage() {}
+
+func (x *ConfirmHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ConfirmHoldResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ListBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBookingsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ListBookingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bookings []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"` // Earliest departure first
+}
+
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Refund  *Money   `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"` // Set when the booking was paid for
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CancelBookingResponse) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// Moves a booking to another seat, settling any fare difference. Give
+// either new_seat or section.
+type ChangeSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string         `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	NewSeat   string         `protobuf:"bytes,2,opt,name=new_seat,json=newSeat,proto3" json:"new_seat,omitempty"`
+	Section   string         `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"` // Any vacant seat of this section
+	Payment   *PaymentMethod `protobuf:"bytes,4,opt,name=payment,proto3" json:"payment,omitempty"` // Pays the difference when the new seat is dearer
+	Price     *Money         `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`     // Optional: the new fare the client agreed to
+}
+
+func (x *ChangeSeatRequest) Reset() {
+	*x = ChangeSeatRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatRequest) ProtoMessage() {}
+
+func (x *ChangeSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSeatRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChangeSeatRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *ChangeSeatRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ChangeSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking  *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Charged  *Money   `protobuf:"bytes,2,opt,name=charged,proto3" json:"charged,omitempty"`   // Set when the difference was paid
+	Refunded *Money   `protobuf:"bytes,3,opt,name=refunded,proto3" json:"refunded,omitempty"` // Set when the difference was paid back
+}
+
+func (x *ChangeSeatResponse) Reset() {
+	*x = ChangeSeatResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatResponse) ProtoMessage() {}
+
+func (x *ChangeSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSeatResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChangeSeatResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ChangeSeatResponse) GetCharged() *Money {
+	if x != nil {
+		return x.Charged
+	}
+	return nil
+}
+
+func (x *ChangeSeatResponse) GetRefunded() *Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+// Offers to swap seats with another booking, or accepts its offer. Both
+// passengers must call SwapSeats naming each other's booking.
+type SwapSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId      string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Email          string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // Of the passenger of booking_id
+	OtherBookingId string `protobuf:"bytes,3,opt,name=other_booking_id,json=otherBookingId,proto3" json:"other_booking_id,omitempty"`
+}
+
+func (x *SwapSeatsRequest) Reset() {
+	*x = SwapSeatsRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsRequest) ProtoMessage() {}
+
+func (x *SwapSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SwapSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SwapSeatsRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *SwapSeatsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SwapSeatsRequest) GetOtherBookingId() string {
+	if x != nil {
+		return x.OtherBookingId
+	}
+	return ""
+}
+
+type SwapSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swapped bool     `protobuf:"varint,1,opt,name=swapped,proto3" json:"swapped,omitempty"` // False while waiting for the other passenger to agree
+	Booking *Booking `protobuf:"bytes,2,opt,name=booking,proto3" json:"booking,omitempty"`  // The caller's booking
+}
+
+func (x *SwapSeatsResponse) Reset() {
+	*x = SwapSeatsResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsResponse) ProtoMessage() {}
+
+func (x *SwapSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsResponse.ProtoReflect.Descriptor instead.
+func (*SwapSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SwapSeatsResponse) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+func (x *SwapSeatsResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// Books every passenger or none, seated together where possible
+type CreateGroupBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureId string         `protobuf:"bytes,1,opt,name=departure_id,json=departureId,proto3" json:"departure_id,omitempty"`
+	From        string         `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string         `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	TravelClass string         `protobuf:"bytes,4,opt,name=travel_class,json=travelClass,proto3" json:"travel_class,omitempty"`
+	Passengers  []*Passenger   `protobuf:"bytes,5,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	Payment     *PaymentMethod `protobuf:"bytes,6,opt,name=payment,proto3" json:"payment,omitempty"` // Pays for the whole group
+	Price       *Money         `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`     // Optional; when set it must match the total fare
+}
+
+func (x *CreateGroupBookingRequest) Reset() {
+	*x = CreateGroupBookingRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupBookingRequest) ProtoMessage() {}
+
+func (x *CreateGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateGroupBookingRequest) GetDepartureId() string {
+	if x != nil {
+		return x.DepartureId
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *CreateGroupBookingRequest) GetPassengers() []*Passenger {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *CreateGroupBookingRequest) GetPayment() *PaymentMethod {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *CreateGroupBookingRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type GroupBooking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId  string     `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Bookings []*Booking `protobuf:"bytes,2,rep,name=bookings,proto3" json:"bookings,omitempty"` // One per passenger, in seat order
+	Total    *Money     `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GroupBooking) Reset() {
+	*x = GroupBooking{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBooking) ProtoMessage() {}
+
+func (x *GroupBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBooking.ProtoReflect.Descriptor instead.
+func (*GroupBooking) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GroupBooking) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupBooking) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *GroupBooking) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type CreateGroupBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *GroupBooking `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *CreateGroupBookingResponse) Reset() {
+	*x = CreateGroupBookingResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupBookingResponse) ProtoMessage() {}
+
+func (x *CreateGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateGroupBookingResponse) GetGroup() *GroupBooking {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GetGroupBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *GetGroupBookingRequest) Reset() {
+	*x = GetGroupBookingRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupBookingRequest) ProtoMessage() {}
+
+func (x *GetGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGroupBookingRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetGroupBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *GroupBooking `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *GetGroupBookingResponse) Reset() {
+	*x = GetGroupBookingResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupBookingResponse) ProtoMessage() {}
+
+func (x *GetGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGroupBookingResponse) GetGroup() *GroupBooking {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type CancelGroupBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *CancelGroupBookingRequest) Reset() {
+	*x = CancelGroupBookingRequest{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupBookingRequest) ProtoMessage() {}
+
+func (x *CancelGroupBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelGroupBookingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CancelGroupBookingRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type CancelGroupBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group  *GroupBooking `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Refund *Money        `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"` // Set when the group was paid for
+}
+
+func (x *CancelGroupBookingResponse) Reset() {
+	*x = CancelGroupBookingResponse{}
+	mi := &file_ticket_v2_ticket_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGroupBookingResponse) ProtoMessage() {}
+
+func (x *CancelGroupBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_v2_ticket_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGroupBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelGroupBookingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_v2_ticket_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CancelGroupBookingResponse) GetGroup() *GroupBooking {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *CancelGroupBookingResponse) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+var File_ticket_v2_ticket_proto protoreflect.FileDescriptor
+
+var file_ticket_v2_ticket_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a,
+	0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0x8a, 0xb5, 0x18, 0x0c, 0x2a, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x30, 0x01, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x05,
+	0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x30, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x7e, 0x0a, 0x09, 0x50, 0x61,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01,
+	0x20, 0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2e, 0x0a, 0x0d, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x8a, 0xb5, 0x18, 0x03,
+	0x20, 0xc8, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xec, 0x05, 0x0a, 0x07, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x73, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x52, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x42, 0x0a, 0x0f,
+	0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x3f, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x07, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x10,
+	0x66, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0f, 0x66, 0x61, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x46, 0x61, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52,
+	0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0f, 0x6e, 0x65,
+	0x61, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a,
+	0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0d, 0x6e, 0x65, 0x61, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x3e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x22, 0xad, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x74, 0x73,
+	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0xa6, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x20, 0x64, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x22, 0x8a, 0xb5, 0x18, 0x1e, 0x2a, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d,
+	0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x32, 0x7d, 0x24, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x0b, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x20, 0x64, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x05,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x04, 0x66, 0x61, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x74,
+	0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x28, 0x0a,
+	0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52,
+	0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xa6, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x70, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x6f,
+	0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x52, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x66, 0x61, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x65,
+	0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x61, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x69,
+	0x73, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x69, 0x73, 0x6c, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x60, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04,
+	0x08, 0x01, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x80, 0x02, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x9a, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x0b, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x09, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x94, 0x02, 0x0a, 0x0f, 0x48, 0x6f, 0x6c,
+	0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x40, 0x0a, 0x10, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5,
+	0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b,
+	0x38, 0x7d, 0x24, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22,
+	0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01,
+	0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x38,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01, 0x20, 0xfe,
+	0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x46, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x4c, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5,
+	0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b,
+	0x38, 0x7d, 0x24, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x6f,
+	0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22,
+	0xea, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08,
+	0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24,
+	0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x6e,
+	0x65, 0x77, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x12, 0x20,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x9c, 0x01, 0x0a,
+	0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x2c, 0x0a,
+	0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x10,
+	0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x09, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x8a, 0xb5, 0x18, 0x07, 0x08, 0x01, 0x10, 0x01, 0x20,
+	0xfe, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3f, 0x0a, 0x10, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x0e, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x11, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xc3, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x08, 0x01, 0x20, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x20, 0x64, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x16,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x20, 0x64, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb5,
+	0x18, 0x02, 0x20, 0x32, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x38, 0x01, 0x40, 0x14, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x73, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x81, 0x01,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x4b, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x4a,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11,
+	0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d,
+	0x24, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x22, 0x4d, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x08, 0x01, 0x2a, 0x0d, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x32, 0x2d, 0x37, 0x5d, 0x7b, 0x38, 0x7d, 0x24, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x2a, 0xa3, 0x01, 0x0a, 0x0d, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
+	0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4f,
+	0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c,
+	0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x4f, 0x4f, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x2a, 0xaf, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x04, 0x2a, 0x60, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x49, 0x53,
+	0x4c, 0x45, 0x10, 0x02, 0x2a, 0x86, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x46, 0x52, 0x45, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x32, 0xb8, 0x0a,
+	0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x4b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x20,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x12, 0x1a, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0a, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x08, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x64, 0x61, 0x6e, 0x6b, 0x75,
+	0x6d, 0x61, 0x72, 0x32, 0x35, 0x31, 0x37, 0x2f, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ticket_v2_ticket_proto_rawDescOnce sync.Once
+	file_ticket_v2_ticket_proto_rawDescData = file_ticket_v2_ticket_proto_rawDesc
+)
+
+func file_ticket_v2_ticket_proto_rawDescGZIP() []byte {
+	file_ticket_v2_ticket_proto_rawDescOnce.Do(func() {
+		file_ticket_v2_ticket_proto_rawDescData = protoimpl.X.CompressGZIP(file_ticket_v2_ticket_proto_rawDescData)
+	})
+	return file_ticket_v2_ticket_proto_rawDescData
+}
+
+var file_ticket_v2_ticket_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ticket_v2_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_ticket_v2_ticket_proto_goTypes = []any{
+	(BookingStatus)(0),                 // 0: ticket.v2.BookingStatus
+	(PaymentStatus)(0),                 // 1: ticket.v2.PaymentStatus
+	(SeatPosition)(0),                  // 2: ticket.v2.SeatPosition
+	(SeatStatus)(0),                    // 3: ticket.v2.SeatStatus
+	(*Money)(nil),                      // 4: ticket.v2.Money
+	(*Passenger)(nil),                  // 5: ticket.v2.Passenger
+	(*PaymentMethod)(nil),              // 6: ticket.v2.PaymentMethod
+	(*Booking)(nil),                    // 7: ticket.v2.Booking
+	(*Payment)(nil),                    // 8: ticket.v2.Payment
+	(*FareAdjustment)(nil),             // 9: ticket.v2.FareAdjustment
+	(*SwapOffer)(nil),                  // 10: ticket.v2.SwapOffer
+	(*SeatPreference)(nil),             // 11: ticket.v2.SeatPreference
+	(*Route)(nil),                      // 12: ticket.v2.Route
+	(*ListRoutesRequest)(nil),          // 13: ticket.v2.ListRoutesRequest
+	(*ListRoutesResponse)(nil),         // 14: ticket.v2.ListRoutesResponse
+	(*Departure)(nil),                  // 15: ticket.v2.Departure
+	(*ListDeparturesRequest)(nil),      // 16: ticket.v2.ListDeparturesRequest
+	(*ListDeparturesResponse)(nil),     // 17: ticket.v2.ListDeparturesResponse
+	(*GetQuoteRequest)(nil),            // 18: ticket.v2.GetQuoteRequest
+	(*Quote)(nil),                      // 19: ticket.v2.Quote
+	(*GetQuoteResponse)(nil),           // 20: ticket.v2.GetQuoteResponse
+	(*GetSeatMapRequest)(nil),          // 21: ticket.v2.GetSeatMapRequest
+	(*GetSeatMapResponse)(nil),         // 22: ticket.v2.GetSeatMapResponse
+	(*SectionMap)(nil),                 // 23: ticket.v2.SectionMap
+	(*SeatInfo)(nil),                   // 24: ticket.v2.SeatInfo
+	(*WatchSeatsRequest)(nil),          // 25: ticket.v2.WatchSeatsRequest
+	(*WatchSeatsResponse)(nil),         // 26: ticket.v2.WatchSeatsResponse
+	(*CreateBookingRequest)(nil),       // 27: ticket.v2.CreateBookingRequest
+	(*CreateBookingResponse)(nil),      // 28: ticket.v2.CreateBookingResponse
+	(*HoldSeatRequest)(nil),            // 29: ticket.v2.HoldSeatRequest
+	(*HoldSeatResponse)(nil),           // 30: ticket.v2.HoldSeatResponse
+	(*ConfirmHoldRequest)(nil),         // 31: ticket.v2.ConfirmHoldRequest
+	(*ConfirmHoldResponse)(nil),        // 32: ticket.v2.ConfirmHoldResponse
+	(*GetBookingRequest)(nil),          // 33: ticket.v2.GetBookingRequest
+	(*GetBookingResponse)(nil),         // 34: ticket.v2.GetBookingResponse
+	(*ListBookingsRequest)(nil),        // 35: ticket.v2.ListBookingsRequest
+	(*ListBookingsResponse)(nil),       // 36: ticket.v2.ListBookingsResponse
+	(*CancelBookingRequest)(nil),       // 37: ticket.v2.CancelBookingRequest
+	(*CancelBookingResponse)(nil),      // 38: ticket.v2.CancelBookingResponse
+	(*ChangeSeatRequest)(nil),          // 39: ticket.v2.ChangeSeatRequest
+	(*ChangeSeatResponse)(nil),         // 40: ticket.v2.ChangeSeatResponse
+	(*SwapSeatsRequest)(nil),           // 41: ticket.v2.SwapSeatsRequest
+	(*SwapSeatsResponse)(nil),          // 42: ticket.v2.SwapSeatsResponse
+	(*CreateGroupBookingRequest)(nil),  // 43: ticket.v2.CreateGroupBookingRequest
+	(*GroupBooking)(nil),               // 44: ticket.v2.GroupBooking
+	(*CreateGroupBookingResponse)(nil), // 45: ticket.v2.CreateGroupBookingResponse
+	(*GetGroupBookingRequest)(nil),     // 46: ticket.v2.GetGroupBookingRequest
+	(*GetGroupBookingResponse)(nil),    // 47: ticket.v2.GetGroupBookingResponse
+	(*CancelGroupBookingRequest)(nil),  // 48: ticket.v2.CancelGroupBookingRequest
+	(*CancelGroupBookingResponse)(nil), // 49: ticket.v2.CancelGroupBookingResponse
+	(*timestamppb.Timestamp)(nil),      // 50: google.protobuf.Timestamp
+}
+var file_ticket_v2_ticket_proto_depIdxs = []int32{
+	50, // 0: ticket.v2.Booking.departs_at:type_name -> google.protobuf.Timestamp
+	5,  // 1: ticket.v2.Booking.passenger:type_name -> ticket.v2.Passenger
+	4,  // 2: ticket.v2.Booking.price:type_name -> ticket.v2.Money
+	0,  // 3: ticket.v2.Booking.status:type_name -> ticket.v2.BookingStatus
+	50, // 4: ticket.v2.Booking.hold_expires_at:type_name -> google.protobuf.Timestamp
+	50, // 5: ticket.v2.Booking.waitlisted_at:type_name -> google.protobuf.Timestamp
+	50, // 6: ticket.v2.Booking.cancelled_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: ticket.v2.Booking.payment:type_name -> ticket.v2.Payment
+	10, // 8: ticket.v2.Booking.swap_offer:type_name -> ticket.v2.SwapOffer
+	11, // 9: ticket.v2.Booking.preference:type_name -> ticket.v2.SeatPreference
+	1,  // 10: ticket.v2.Payment.status:type_name -> ticket.v2.PaymentStatus
+	4,  // 11: ticket.v2.Payment.refunded:type_name -> ticket.v2.Money
+	9,  // 12: ticket.v2.Payment.fare_adjustments:type_name -> ticket.v2.FareAdjustment
+	4,  // 13: ticket.v2.FareAdjustment.amount:type_name -> ticket.v2.Money
+	4,  // 14: ticket.v2.FareAdjustment.refunded:type_name -> ticket.v2.Money
+	2,  // 15: ticket.v2.SeatPreference.position:type_name -> ticket.v2.SeatPosition
+	12, // 16: ticket.v2.ListRoutesResponse.routes:type_name -> ticket.v2.Route
+	50, // 17: ticket.v2.Departure.departs_at:type_name -> google.protobuf.Timestamp
+	15, // 18: ticket.v2.ListDeparturesResponse.departures:type_name -> ticket.v2.Departure
+	4,  // 19: ticket.v2.Quote.fare:type_name -> ticket.v2.Money
+	19, // 20: ticket.v2.GetQuoteResponse.quotes:type_name -> ticket.v2.Quote
+	23, // 21: ticket.v2.GetSeatMapResponse.sections:type_name -> ticket.v2.SectionMap
+	4,  // 22: ticket.v2.SectionMap.fare:type_name -> ticket.v2.Money
+	24, // 23: ticket.v2.SectionMap.seats:type_name -> ticket.v2.SeatInfo
+	3,  // 24: ticket.v2.SeatInfo.status:type_name -> ticket.v2.SeatStatus
+	3,  // 25: ticket.v2.WatchSeatsResponse.status:type_name -> ticket.v2.SeatStatus
+	50, // 26: ticket.v2.WatchSeatsResponse.time:type_name -> google.protobuf.Timestamp
+	5,  // 27: ticket.v2.CreateBookingRequest.passenger:type_name -> ticket.v2.Passenger
+	11, // 28: ticket.v2.CreateBookingRequest.preference:type_name -> ticket.v2.SeatPreference
+	6,  // 29: ticket.v2.CreateBookingRequest.payment:type_name -> ticket.v2.PaymentMethod
+	4,  // 30: ticket.v2.CreateBookingRequest.price:type_name -> ticket.v2.Money
+	7,  // 31: ticket.v2.CreateBookingResponse.booking:type_name -> ticket.v2.Booking
+	5,  // 32: ticket.v2.HoldSeatRequest.passenger:type_name -> ticket.v2.Passenger
+	11, // 33: ticket.v2.HoldSeatRequest.preference:type_name -> ticket.v2.SeatPreference
+	7,  // 34: ticket.v2.HoldSeatResponse.booking:type_name -> ticket.v2.Booking
+	6,  // 35: ticket.v2.ConfirmHoldRequest.payment:type_name -> ticket.v2.PaymentMethod
+	4,  // 36: ticket.v2.ConfirmHoldRequest.price:type_name -> ticket.v2.Money
+	7,  // 37: ticket.v2.ConfirmHoldResponse.booking:type_name -> ticket.v2.Booking
+	7,  // 38: ticket.v2.GetBookingResponse.booking:type_name -> ticket.v2.Booking
+	7,  // 39: ticket.v2.ListBookingsResponse.bookings:type_name -> ticket.v2.Booking
+	7,  // 40: ticket.v2.CancelBookingResponse.booking:type_name -> ticket.v2.Booking
+	4,  // 41: ticket.v2.CancelBookingResponse.refund:type_name -> ticket.v2.Money
+	6,  // 42: ticket.v2.ChangeSeatRequest.payment:type_name -> ticket.v2.PaymentMethod
+	4,  // 43: ticket.v2.ChangeSeatRequest.price:type_name -> ticket.v2.Money
+	7,  // 44: ticket.v2.ChangeSeatResponse.booking:type_name -> ticket.v2.Booking
+	4,  // 45: ticket.v2.ChangeSeatResponse.charged:type_name -> ticket.v2.Money
+	4,  // 46: ticket.v2.ChangeSeatResponse.refunded:type_name -> ticket.v2.Money
+	7,  // 47: ticket.v2.SwapSeatsResponse.booking:type_name -> ticket.v2.Booking
+	5,  // 48: ticket.v2.CreateGroupBookingRequest.passengers:type_name -> ticket.v2.Passenger
+	6,  // 49: ticket.v2.CreateGroupBookingRequest.payment:type_name -> ticket.v2.PaymentMethod
+	4,  // 50: ticket.v2.CreateGroupBookingRequest.price:type_name -> ticket.v2.Money
+	7,  // 51: ticket.v2.GroupBooking.bookings:type_name -> ticket.v2.Booking
+	4,  // 52: ticket.v2.GroupBooking.total:type_name -> ticket.v2.Money
+	44, // 53: ticket.v2.CreateGroupBookingResponse.group:type_name -> ticket.v2.GroupBooking
+	44, // 54: ticket.v2.GetGroupBookingResponse.group:type_name -> ticket.v2.GroupBooking
+	44, // 55: ticket.v2.CancelGroupBookingResponse.group:type_name -> ticket.v2.GroupBooking
+	4,  // 56: ticket.v2.CancelGroupBookingResponse.refund:type_name -> ticket.v2.Money
+	13, // 57: ticket.v2.TicketService.ListRoutes:input_type -> ticket.v2.ListRoutesRequest
+	16, // 58: ticket.v2.TicketService.ListDepartures:input_type -> ticket.v2.ListDeparturesRequest
+	18, // 59: ticket.v2.TicketService.GetQuote:input_type -> ticket.v2.GetQuoteRequest
+	21, // 60: ticket.v2.TicketService.GetSeatMap:input_type -> ticket.v2.GetSeatMapRequest
+	25, // 61: ticket.v2.TicketService.WatchSeats:input_type -> ticket.v2.WatchSeatsRequest
+	27, // 62: ticket.v2.TicketService.CreateBooking:input_type -> ticket.v2.CreateBookingRequest
+	29, // 63: ticket.v2.TicketService.HoldSeat:input_type -> ticket.v2.HoldSeatRequest
+	31, // 64: ticket.v2.TicketService.ConfirmHold:input_type -> ticket.v2.ConfirmHoldRequest
+	33, // 65: ticket.v2.TicketService.GetBooking:input_type -> ticket.v2.GetBookingRequest
+	35, // 66: ticket.v2.TicketService.ListBookings:input_type -> ticket.v2.ListBookingsRequest
+	37, // 67: ticket.v2.TicketService.CancelBooking:input_type -> ticket.v2.CancelBookingRequest
+	39, // 68: ticket.v2.TicketService.ChangeSeat:input_type -> ticket.v2.ChangeSeatRequest
+	41, // 69: ticket.v2.TicketService.SwapSeats:input_type -> ticket.v2.SwapSeatsRequest
+	43, // 70: ticket.v2.TicketService.CreateGroupBooking:input_type -> ticket.v2.CreateGroupBookingRequest
+	46, // 71: ticket.v2.TicketService.GetGroupBooking:input_type -> ticket.v2.GetGroupBookingRequest
+	48, // 72: ticket.v2.TicketService.CancelGroupBooking:input_type -> ticket.v2.CancelGroupBookingRequest
+	14, // 73: ticket.v2.TicketService.ListRoutes:output_type -> ticket.v2.ListRoutesResponse
+	17, // 74: ticket.v2.TicketService.ListDepartures:output_type -> ticket.v2.ListDeparturesResponse
+	20, // 75: ticket.v2.TicketService.GetQuote:output_type -> ticket.v2.GetQuoteResponse
+	22, // 76: ticket.v2.TicketService.GetSeatMap:output_type -> ticket.v2.GetSeatMapResponse
+	26, // 77: ticket.v2.TicketService.WatchSeats:output_type -> ticket.v2.WatchSeatsResponse
+	28, // 78: ticket.v2.TicketService.CreateBooking:output_type -> ticket.v2.CreateBookingResponse
+	30, // 79: ticket.v2.TicketService.HoldSeat:output_type -> ticket.v2.HoldSeatResponse
+	32, // 80: ticket.v2.TicketService.ConfirmHold:output_type -> ticket.v2.ConfirmHoldResponse
+	34, // 81: ticket.v2.TicketService.GetBooking:output_type -> ticket.v2.GetBookingResponse
+	36, // 82: ticket.v2.TicketService.ListBookings:output_type -> ticket.v2.ListBookingsResponse
+	38, // 83: ticket.v2.TicketService.CancelBooking:output_type -> ticket.v2.CancelBookingResponse
+	40, // 84: ticket.v2.TicketService.ChangeSeat:output_type -> ticket.v2.ChangeSeatResponse
+	42, // 85: ticket.v2.TicketService.SwapSeats:output_type -> ticket.v2.SwapSeatsResponse
+	45, // 86: ticket.v2.TicketService.CreateGroupBooking:output_type -> ticket.v2.CreateGroupBookingResponse
+	47, // 87: ticket.v2.TicketService.GetGroupBooking:output_type -> ticket.v2.GetGroupBookingResponse
+	49, // 88: ticket.v2.TicketService.CancelGroupBooking:output_type -> ticket.v2.CancelGroupBookingResponse
+	73, // [73:89] is the sub-list for method output_type
+	57, // [57:73] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
+}
+
+func init() { file_ticket_v2_ticket_proto_init() }
+func file_ticket_v2_ticket_proto_init() {
+	if File_ticket_v2_ticket_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ticket_v2_ticket_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   46,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ticket_v2_ticket_proto_goTypes,
+		DependencyIndexes: file_ticket_v2_ticket_proto_depIdxs,
+		EnumInfos:         file_ticket_v2_ticket_proto_enumTypes,
+		MessageInfos:      file_ticket_v2_ticket_proto_msgTypes,
+	}.Build()
+	File_ticket_v2_ticket_proto = out.File
+	file_ticket_v2_ticket_proto_rawDesc = nil
+	file_ticket_v2_ticket_proto_goTypes = nil
+	file_ticket_v2_ticket_proto_depIdxs = nil
+}
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ticket/v2/ticket.proto
+
+package ticketv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TicketService_ListRoutes_FullMethodName         = "/ticket.v2.TicketService/ListRoutes"
+	TicketService_ListDepartures_FullMethodName     = "/ticket.v2.TicketService/ListDepartures"
+	TicketService_GetQuote_FullMethodName           = "/ticket.v2.TicketService/GetQuote"
+	TicketService_GetSeatMap_FullMethodName         = "/ticket.v2.TicketService/GetSeatMap"
+	TicketService_WatchSeats_FullMethodName         = "/ticket.v2.TicketService/WatchSeats"
+	TicketService_CreateBooking_FullMethodName      = "/ticket.v2.TicketService/CreateBooking"
+	TicketService_HoldSeat_FullMethodName           = "/ticket.v2.TicketService/HoldSeat"
+	TicketService_ConfirmHold_FullMethodName        = "/ticket.v2.TicketService/ConfirmHold"
+	TicketService_GetBooking_FullMethodName         = "/ticket.v2.TicketService/GetBooking"
+	TicketService_ListBookings_FullMethodName       = "/ticket.v2.TicketService/ListBookings"
+	TicketService_CancelBooking_FullMethodName      = "/ticket.v2.TicketService/CancelBooking"
+	TicketService_ChangeSeat_FullMethodName         = "/ticket.v2.TicketService/ChangeSeat"
+	TicketService_SwapSeats_FullMethodName          = "/ticket.v2.TicketService/SwapSeats"
+	TicketService_CreateGroupBooking_FullMethodName = "/ticket.v2.TicketService/CreateGroupBooking"
+	TicketService_GetGroupBooking_FullMethodName    = "/ticket.v2.TicketService/GetGroupBooking"
+	TicketService_CancelGroupBooking_FullMethodName = "/ticket.v2.TicketService/CancelGroupBooking"
+)
+
+// TicketServiceClient is the client API for TicketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Sells seats on dated departures and manages the bookings made. Every
+// booking is addressed by its booking_id and every amount is a Money.
+// Errors carry the same details as in ticket.v1.
+type TicketServiceClient interface {
+	ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc.CallOption) (*ListRoutesResponse, error)
+	ListDepartures(ctx context.Context, in *ListDeparturesRequest, opts ...grpc.CallOption) (*ListDeparturesResponse, error)
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
+	GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error)
+	WatchSeats(ctx context.Context, in *WatchSeatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSeatsResponse], error)
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
+	HoldSeat(ctx context.Context, in *HoldSeatRequest, opts ...grpc.CallOption) (*HoldSeatResponse, error)
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error)
+	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
+	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*ChangeSeatResponse, error)
+	SwapSeats(ctx context.Context, in *SwapSeatsRequest, opts ...grpc.CallOption) (*SwapSeatsResponse, error)
+	CreateGroupBooking(ctx context.Context, in *CreateGroupBookingRequest, opts ...grpc.CallOption) (*CreateGroupBookingResponse, error)
+	GetGroupBooking(ctx context.Context, in *GetGroupBookingRequest, opts ...grpc.CallOption) (*GetGroupBookingResponse, error)
+	CancelGroupBooking(ctx context.Context, in *CancelGroupBookingRequest, opts ...grpc.CallOption) (*CancelGroupBookingResponse, error)
+}
+
+type ticketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicketServiceClient(cc grpc.ClientConnInterface) TicketServiceClient {
+	return &ticketServiceClient{cc}
+}
+
+func (c *ticketServiceClient) ListRoutes(ctx context.Context, in *ListRoutesRequest, opts ...grpc.CallOption) (*ListRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoutesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListDepartures(ctx context.Context, in *ListDeparturesRequest, opts ...grpc.CallOption) (*ListDeparturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeparturesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListDepartures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatMapResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) WatchSeats(ctx context.Context, in *WatchSeatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSeatsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TicketService_ServiceDesc.Streams[0], TicketService_WatchSeats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSeatsRequest, WatchSeatsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicketService_WatchSeatsClient = grpc.ServerStreamingClient[WatchSeatsResponse]
+
+func (c *ticketServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookingResponse)
+	err := c.cc.Invoke(ctx, TicketService_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) HoldSeat(ctx context.Context, in *HoldSeatRequest, opts ...grpc.CallOption) (*HoldSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldSeatResponse)
+	err := c.cc.Invoke(ctx, TicketService_HoldSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmHoldResponse)
+	err := c.cc.Invoke(ctx, TicketService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, TicketService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*ChangeSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeSeatResponse)
+	err := c.cc.Invoke(ctx, TicketService_ChangeSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SwapSeats(ctx context.Context, in *SwapSeatsRequest, opts ...grpc.CallOption) (*SwapSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapSeatsResponse)
+	err := c.cc.Invoke(ctx, TicketService_SwapSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CreateGroupBooking(ctx context.Context, in *CreateGroupBookingRequest, opts ...grpc.CallOption) (*CreateGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupBookingResponse)
+	err := c.cc.Invoke(ctx, TicketService_CreateGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetGroupBooking(ctx context.Context, in *GetGroupBookingRequest, opts ...grpc.CallOption) (*GetGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupBookingResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelGroupBooking(ctx context.Context, in *CancelGroupBookingRequest, opts ...grpc.CallOption) (*CancelGroupBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGroupBookingResponse)
+	err := c.cc.Invoke(ctx, TicketService_CancelGroupBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicketServiceServer is the server API for TicketService service.
+// All implementations must embed UnimplementedTicketServiceServer
+// for forward compatibility.
+//
+// Sells seats on dated departures and manages the bookings made. Every
+// booking is addressed by its booking_id and every amount is a Money.
+// Errors carry the same details as in ticket.v1.
+type TicketServiceServer interface {
+	ListRoutes(context.Context, *ListRoutesRequest) (*ListRoutesResponse, error)
+	ListDepartures(context.Context, *ListDeparturesRequest) (*ListDeparturesResponse, error)
+	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
+	GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error)
+	WatchSeats(*WatchSeatsRequest, grpc.ServerStreamingServer[WatchSeatsResponse]) error
+	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
+	HoldSeat(context.Context, *HoldSeatRequest) (*HoldSeatResponse, error)
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error)
+	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
+	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	ChangeSeat(context.Context, *ChangeSeatRequest) (*ChangeSeatResponse, error)
+	SwapSeats(context.Context, *SwapSeatsRequest) (*SwapSeatsResponse, error)
+	CreateGroupBooking(context.Context, *CreateGroupBookingRequest) (*CreateGroupBookingResponse, error)
+	GetGroupBooking(context.Context, *GetGroupBookingRequest) (*GetGroupBookingResponse, error)
+	CancelGroupBooking(context.Context, *CancelGroupBookingRequest) (*CancelGroupBookingResponse, error)
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+// UnimplementedTicketServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTicketServiceServer struct{}
+
+func (UnimplementedTicketServiceServer) ListRoutes(context.Context, *ListRoutesRequest) (*ListRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoutes not implemented")
+}
+func (UnimplementedTicketServiceServer) ListDepartures(context.Context, *ListDeparturesRequest) (*ListDeparturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartures not implemented")
+}
+func (UnimplementedTicketServiceServer) GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedTicketServiceServer) GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedTicketServiceServer) WatchSeats(*WatchSeatsRequest, grpc.ServerStreamingServer[WatchSeatsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSeats not implemented")
+}
+func (UnimplementedTicketServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) HoldSeat(context.Context, *HoldSeatRequest) (*HoldSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeat not implemented")
+}
+func (UnimplementedTicketServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedTicketServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) ChangeSeat(context.Context, *ChangeSeatRequest) (*ChangeSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSeat not implemented")
+}
+func (UnimplementedTicketServiceServer) SwapSeats(context.Context, *SwapSeatsRequest) (*SwapSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSeats not implemented")
+}
+func (UnimplementedTicketServiceServer) CreateGroupBooking(context.Context, *CreateGroupBookingRequest) (*CreateGroupBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) GetGroupBooking(context.Context, *GetGroupBookingRequest) (*GetGroupBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelGroupBooking(context.Context, *CancelGroupBookingRequest) (*CancelGroupBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGroupBooking not implemented")
+}
+func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
+func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketServiceServer will
+// result in compilation errors.
+type UnsafeTicketServiceServer interface {
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTicketServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TicketService_ServiceDesc, srv)
+}
+
+func _TicketService_ListRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListRoutes(ctx, req.(*ListRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListDepartures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeparturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListDepartures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListDepartures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListDepartures(ctx, req.(*ListDeparturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, req.(*GetSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_WatchSeats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSeatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicketServiceServer).WatchSeats(m, &grpc.GenericServerStream[WatchSeatsRequest, WatchSeatsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicketService_WatchSeatsServer = grpc.ServerStreamingServer[WatchSeatsResponse]
+
+func _TicketService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_HoldSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).HoldSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_HoldSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).HoldSeat(ctx, req.(*HoldSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListBookings(ctx, req.(*ListBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ChangeSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ChangeSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ChangeSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ChangeSeat(ctx, req.(*ChangeSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SwapSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SwapSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SwapSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SwapSeats(ctx, req.(*SwapSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CreateGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateGroupBooking(ctx, req.(*CreateGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetGroupBooking(ctx, req.(*GetGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelGroupBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGroupBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelGroupBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelGroupBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelGroupBooking(ctx, req.(*CancelGroupBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ticket.v2.TicketService",
+	HandlerType: (*TicketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRoutes",
+			Handler:    _TicketService_ListRoutes_Handler,
+		},
+		{
+			MethodName: "ListDepartures",
+			Handler:    _TicketService_ListDepartures_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _TicketService_GetQuote_Handler,
+		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _TicketService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "CreateBooking",
+			Handler:    _TicketService_CreateBooking_Handler,
+		},
+		{
+			MethodName: "HoldSeat",
+			Handler:    _TicketService_HoldSeat_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _TicketService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _TicketService_GetBooking_Handler,
+		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _TicketService_ListBookings_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _TicketService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "ChangeSeat",
+			Handler:    _TicketService_ChangeSeat_Handler,
+		},
+		{
+			MethodName: "SwapSeats",
+			Handler:    _TicketService_SwapSeats_Handler,
+		},
+		{
+			MethodName: "CreateGroupBooking",
+			Handler:    _TicketService_CreateGroupBooking_Handler,
+		},
+		{
+			MethodName: "GetGroupBooking",
+			Handler:    _TicketService_GetGroupBooking_Handler,
+		},
+		{
+			MethodName: "CancelGroupBooking",
+			Handler:    _TicketService_CancelGroupBooking_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSeats",
+			Handler:       _TicketService_WatchSeats_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ticket/v2/ticket.proto",
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ticket/v2/ticket.proto
+
+package ticketv2connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v2 "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v2"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TicketServiceName is the fully-qualified name of the TicketService service.
+	TicketServiceName = "ticket.v2.TicketService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TicketServiceListRoutesProcedure is the fully-qualified name of the TicketService's ListRoutes
+	// RPC.
+	TicketServiceListRoutesProcedure = "/ticket.v2.TicketService/ListRoutes"
+	// TicketServiceListDeparturesProcedure is the fully-qualified name of the TicketService's
+	// ListDepartures RPC.
+	TicketServiceListDeparturesProcedure = "/ticket.v2.TicketService/ListDepartures"
+	// TicketServiceGetQuoteProcedure is the fully-qualified name of the TicketService's GetQuote RPC.
+	TicketServiceGetQuoteProcedure = "/ticket.v2.TicketService/GetQuote"
+	// TicketServiceGetSeatMapProcedure is the fully-qualified name of the TicketService's GetSeatMap
+	// RPC.
+	TicketServiceGetSeatMapProcedure = "/ticket.v2.TicketService/GetSeatMap"
+	// TicketServiceWatchSeatsProcedure is the fully-qualified name of the TicketService's WatchSeats
+	// RPC.
+	TicketServiceWatchSeatsProcedure = "/ticket.v2.TicketService/WatchSeats"
+	// TicketServiceCreateBookingProcedure is the fully-qualified name of the TicketService's
+	// CreateBooking RPC.
+	TicketServiceCreateBookingProcedure = "/ticket.v2.TicketService/CreateBooking"
+	// TicketServiceHoldSeatProcedure is the fully-qualified name of the TicketService's HoldSeat RPC.
+	TicketServiceHoldSeatProcedure = "/ticket.v2.TicketService/HoldSeat"
+	// TicketServiceConfirmHoldProcedure is the fully-qualified name of the TicketService's ConfirmHold
+	// RPC.
+	TicketServiceConfirmHoldProcedure = "/ticket.v2.TicketService/ConfirmHold"
+	// TicketServiceGetBookingProcedure is the fully-qualified name of the TicketService's GetBooking
+	// RPC.
+	TicketServiceGetBookingProcedure = "/ticket.v2.TicketService/GetBooking"
+	// TicketServiceListBookingsProcedure is the fully-qualified name of the TicketService's
+	// ListBookings RPC.
+	TicketServiceListBookingsProcedure = "/ticket.v2.TicketService/ListBookings"
+	// TicketServiceCancelBookingProcedure is the fully-qualified name of the TicketService's
+	// CancelBooking RPC.
+	TicketServiceCancelBookingProcedure = "/ticket.v2.TicketService/CancelBooking"
+	// TicketServiceChangeSeatProcedure is the fully-qualified name of the TicketService's ChangeSeat
+	// RPC.
+	TicketServiceChangeSeatProcedure = "/ticket.v2.TicketService/ChangeSeat"
+	// TicketServiceSwapSeatsProcedure is the fully-qualified name of the TicketService's SwapSeats RPC.
+	TicketServiceSwapSeatsProcedure = "/ticket.v2.TicketService/SwapSeats"
+	// TicketServiceCreateGroupBookingProcedure is the fully-qualified name of the TicketService's
+	// CreateGroupBooking RPC.
+	TicketServiceCreateGroupBookingProcedure = "/ticket.v2.TicketService/CreateGroupBooking"
+	// TicketServiceGetGroupBookingProcedure is the fully-qualified name of the TicketService's
+	// GetGroupBooking RPC.
+	TicketServiceGetGroupBookingProcedure = "/ticket.v2.TicketService/GetGroupBooking"
+	// TicketServiceCancelGroupBookingProcedure is the fully-qualified name of the TicketService's
+	// CancelGroupBooking RPC.
+	TicketServiceCancelGroupBookingProcedure = "/ticket.v2.TicketService/CancelGroupBooking"
+)
+
+// TicketServiceClient is a client for the ticket.v2.TicketService service.
+type TicketServiceClient interface {
+	ListRoutes(context.Context, *connect.Request[v2.ListRoutesRequest]) (*connect.Response[v2.ListRoutesResponse], error)
+	ListDepartures(context.Context, *connect.Request[v2.ListDeparturesRequest]) (*connect.Response[v2.ListDeparturesResponse], error)
+	GetQuote(context.Context, *connect.Request[v2.GetQuoteRequest]) (*connect.Response[v2.GetQuoteResponse], error)
+	GetSeatMap(context.Context, *connect.Request[v2.GetSeatMapRequest]) (*connect.Response[v2.GetSeatMapResponse], error)
+	WatchSeats(context.Context, *connect.Request[v2.WatchSeatsRequest]) (*connect.ServerStreamForClient[v2.WatchSeatsResponse], error)
+	CreateBooking(context.Context, *connect.Request[v2.CreateBookingRequest]) (*connect.Response[v2.CreateBookingResponse], error)
+	HoldSeat(context.Context, *connect.Request[v2.HoldSeatRequest]) (*connect.Response[v2.HoldSeatResponse], error)
+	ConfirmHold(context.Context, *connect.Request[v2.ConfirmHoldRequest]) (*connect.Response[v2.ConfirmHoldResponse], error)
+	GetBooking(context.Context, *connect.Request[v2.GetBookingRequest]) (*connect.Response[v2.GetBookingResponse], error)
+	ListBookings(context.Context, *connect.Request[v2.ListBookingsRequest]) (*connect.Response[v2.ListBookingsResponse], error)
+	CancelBooking(context.Context, *connect.Request[v2.CancelBookingRequest]) (*connect.Response[v2.CancelBookingResponse], error)
+	ChangeSeat(context.Context, *connect.Request[v2.ChangeSeatRequest]) (*connect.Response[v2.ChangeSeatResponse], error)
+	SwapSeats(context.Context, *connect.Request[v2.SwapSeatsRequest]) (*connect.Response[v2.SwapSeatsResponse], error)
+	CreateGroupBooking(context.Context, *connect.Request[v2.CreateGroupBookingRequest]) (*connect.Response[v2.CreateGroupBookingResponse], error)
+	GetGroupBooking(context.Context, *connect.Request[v2.GetGroupBookingRequest]) (*connect.Response[v2.GetGroupBookingResponse], error)
+	CancelGroupBooking(context.Context, *connect.Request[v2.CancelGroupBookingRequest]) (*connect.Response[v2.CancelGroupBookingResponse], error)
+}
+
+// NewTicketServiceClient constructs a client for the ticket.v2.TicketService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTicketServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TicketServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ticketServiceMethods := v2.File_ticket_v2_ticket_proto.Services().ByName("TicketService").Methods()
+	return &ticketServiceClient{
+		listRoutes: connect.NewClient[v2.ListRoutesRequest, v2.ListRoutesResponse](
+			httpClient,
+			baseURL+TicketServiceListRoutesProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ListRoutes")),
+			connect.WithClientOptions(opts...),
+		),
+		listDepartures: connect.NewClient[v2.ListDeparturesRequest, v2.ListDeparturesResponse](
+			httpClient,
+			baseURL+TicketServiceListDeparturesProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ListDepartures")),
+			connect.WithClientOptions(opts...),
+		),
+		getQuote: connect.NewClient[v2.GetQuoteRequest, v2.GetQuoteResponse](
+			httpClient,
+			baseURL+TicketServiceGetQuoteProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetQuote")),
+			connect.WithClientOptions(opts...),
+		),
+		getSeatMap: connect.NewClient[v2.GetSeatMapRequest, v2.GetSeatMapResponse](
+			httpClient,
+			baseURL+TicketServiceGetSeatMapProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetSeatMap")),
+			connect.WithClientOptions(opts...),
+		),
+		watchSeats: connect.NewClient[v2.WatchSeatsRequest, v2.WatchSeatsResponse](
+			httpClient,
+			baseURL+TicketServiceWatchSeatsProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("WatchSeats")),
+			connect.WithClientOptions(opts...),
+		),
+		createBooking: connect.NewClient[v2.CreateBookingRequest, v2.CreateBookingResponse](
+			httpClient,
+			baseURL+TicketServiceCreateBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("CreateBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		holdSeat: connect.NewClient[v2.HoldSeatRequest, v2.HoldSeatResponse](
+			httpClient,
+			baseURL+TicketServiceHoldSeatProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("HoldSeat")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmHold: connect.NewClient[v2.ConfirmHoldRequest, v2.ConfirmHoldResponse](
+			httpClient,
+			baseURL+TicketServiceConfirmHoldProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ConfirmHold")),
+			connect.WithClientOptions(opts...),
+		),
+		getBooking: connect.NewClient[v2.GetBookingRequest, v2.GetBookingResponse](
+			httpClient,
+			baseURL+TicketServiceGetBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		listBookings: connect.NewClient[v2.ListBookingsRequest, v2.ListBookingsResponse](
+			httpClient,
+			baseURL+TicketServiceListBookingsProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ListBookings")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelBooking: connect.NewClient[v2.CancelBookingRequest, v2.CancelBookingResponse](
+			httpClient,
+			baseURL+TicketServiceCancelBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("CancelBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		changeSeat: connect.NewClient[v2.ChangeSeatRequest, v2.ChangeSeatResponse](
+			httpClient,
+			baseURL+TicketServiceChangeSeatProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("ChangeSeat")),
+			connect.WithClientOptions(opts...),
+		),
+		swapSeats: connect.NewClient[v2.SwapSeatsRequest, v2.SwapSeatsResponse](
+			httpClient,
+			baseURL+TicketServiceSwapSeatsProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("SwapSeats")),
+			connect.WithClientOptions(opts...),
+		),
+		createGroupBooking: connect.NewClient[v2.CreateGroupBookingRequest, v2.CreateGroupBookingResponse](
+			httpClient,
+			baseURL+TicketServiceCreateGroupBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("CreateGroupBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupBooking: connect.NewClient[v2.GetGroupBookingRequest, v2.GetGroupBookingResponse](
+			httpClient,
+			baseURL+TicketServiceGetGroupBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("GetGroupBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelGroupBooking: connect.NewClient[v2.CancelGroupBookingRequest, v2.CancelGroupBookingResponse](
+			httpClient,
+			baseURL+TicketServiceCancelGroupBookingProcedure,
+			connect.WithSchema(ticketServiceMethods.ByName("CancelGroupBooking")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ticketServiceClient implements TicketServiceClient.
+type ticketServiceClient struct {
+	listRoutes         *connect.Client[v2.ListRoutesRequest, v2.ListRoutesResponse]
+	listDepartures     *connect.Client[v2.ListDeparturesRequest, v2.ListDeparturesResponse]
+	getQuote           *connect.Client[v2.GetQuoteRequest, v2.GetQuoteResponse]
+	getSeatMap         *connect.Client[v2.GetSeatMapRequest, v2.GetSeatMapResponse]
+	watchSeats         *connect.Client[v2.WatchSeatsRequest, v2.WatchSeatsResponse]
+	createBooking      *connect.Client[v2.CreateBookingRequest, v2.CreateBookingResponse]
+	holdSeat           *connect.Client[v2.HoldSeatRequest, v2.HoldSeatResponse]
+	confirmHold        *connect.Client[v2.ConfirmHoldRequest, v2.ConfirmHoldResponse]
+	getBooking         *connect.Client[v2.GetBookingRequest, v2.GetBookingResponse]
+	listBookings       *connect.Client[v2.ListBookingsRequest, v2.ListBookingsResponse]
+	cancelBooking      *connect.Client[v2.CancelBookingRequest, v2.CancelBookingResponse]
+	changeSeat         *connect.Client[v2.ChangeSeatRequest, v2.ChangeSeatResponse]
+	swapSeats          *connect.Client[v2.SwapSeatsRequest, v2.SwapSeatsResponse]
+	createGroupBooking *connect.Client[v2.CreateGroupBookingRequest, v2.CreateGroupBookingResponse]
+	getGroupBooking    *connect.Client[v2.GetGroupBookingRequest, v2.GetGroupBookingResponse]
+	cancelGroupBooking *connect.Client[v2.CancelGroupBookingRequest, v2.CancelGroupBookingResponse]
+}
+
+// ListRoutes calls ticket.v2.TicketService.ListRoutes.
+func (c *ticketServiceClient) ListRoutes(ctx context.Context, req *connect.Request[v2.ListRoutesRequest]) (*connect.Response[v2.ListRoutesResponse], error) {
+	return c.listRoutes.CallUnary(ctx, req)
+}
+
+// ListDepartures calls ticket.v2.TicketService.ListDepartures.
+func (c *ticketServiceClient) ListDepartures(ctx context.Context, req *connect.Request[v2.ListDeparturesRequest]) (*connect.Response[v2.ListDeparturesResponse], error) {
+	return c.listDepartures.CallUnary(ctx, req)
+}
+
+// GetQuote calls ticket.v2.TicketService.GetQuote.
+func (c *ticketServiceClient) GetQuote(ctx context.Context, req *connect.Request[v2.GetQuoteRequest]) (*connect.Response[v2.GetQuoteResponse], error) {
+	return c.getQuote.CallUnary(ctx, req)
+}
+
+// GetSeatMap calls ticket.v2.TicketService.GetSeatMap.
+func (c *ticketServiceClient) GetSeatMap(ctx context.Context, req *connect.Request[v2.GetSeatMapRequest]) (*connect.Response[v2.GetSeatMapResponse], error) {
+	return c.getSeatMap.CallUnary(ctx, req)
+}
+
+// WatchSeats calls ticket.v2.TicketService.WatchSeats.
+func (c *ticketServiceClient) WatchSeats(ctx context.Context, req *connect.Request[v2.WatchSeatsRequest]) (*connect.ServerStreamForClient[v2.WatchSeatsResponse], error) {
+	return c.watchSeats.CallServerStream(ctx, req)
+}
+
+// CreateBooking calls ticket.v2.TicketService.CreateBooking.
+func (c *ticketServiceClient) CreateBooking(ctx context.Context, req *connect.Request[v2.CreateBookingRequest]) (*connect.Response[v2.CreateBookingResponse], error) {
+	return c.createBooking.CallUnary(ctx, req)
+}
+
+// HoldSeat calls ticket.v2.TicketService.HoldSeat.
+func (c *ticketServiceClient) HoldSeat(ctx context.Context, req *connect.Request[v2.HoldSeatRequest]) (*connect.Response[v2.HoldSeatResponse], error) {
+	return c.holdSeat.CallUnary(ctx, req)
+}
+
+// ConfirmHold calls ticket.v2.TicketService.ConfirmHold.
+func (c *ticketServiceClient) ConfirmHold(ctx context.Context, req *connect.Request[v2.ConfirmHoldRequest]) (*connect.Response[v2.ConfirmHoldResponse], error) {
+	return c.confirmHold.CallUnary(ctx, req)
+}
+
+// GetBooking calls ticket.v2.TicketService.GetBooking.
+func (c *ticketServiceClient) GetBooking(ctx context.Context, req *connect.Request[v2.GetBookingRequest]) (*connect.Response[v2.GetBookingResponse], error) {
+	return c.getBooking.CallUnary(ctx, req)
+}
+
+// ListBookings calls ticket.v2.TicketService.ListBookings.
+func (c *ticketServiceClient) ListBookings(ctx context.Context, req *connect.Request[v2.ListBookingsRequest]) (*connect.Response[v2.ListBookingsResponse], error) {
+	return c.listBookings.CallUnary(ctx, req)
+}
+
+// CancelBooking calls ticket.v2.TicketService.CancelBooking.
+func (c *ticketServiceClient) CancelBooking(ctx context.Context, req *connect.Request[v2.CancelBookingRequest]) (*connect.Response[v2.CancelBookingResponse], error) {
+	return c.cancelBooking.CallUnary(ctx, req)
+}
+
+// ChangeSeat calls ticket.v2.TicketService.ChangeSeat.
+func (c *ticketServiceClient) ChangeSeat(ctx context.Context, req *connect.Request[v2.ChangeSeatRequest]) (*connect.Response[v2.ChangeSeatResponse], error) {
+	return c.changeSeat.CallUnary(ctx, req)
+}
+
+// SwapSeats calls ticket.v2.TicketService.SwapSeats.
+func (c *ticketServiceClient) SwapSeats(ctx context.Context, req *connect.Request[v2.SwapSeatsRequest]) (*connect.Response[v2.SwapSeatsResponse], error) {
+	return c.swapSeats.CallUnary(ctx, req)
+}
+
+// CreateGroupBooking calls ticket.v2.TicketService.CreateGroupBooking.
+func (c *ticketServiceClient) CreateGroupBooking(ctx context.Context, req *connect.Request[v2.CreateGroupBookingRequest]) (*connect.Response[v2.CreateGroupBookingResponse], error) {
+	return c.createGroupBooking.CallUnary(ctx, req)
+}
+
+// GetGroupBooking calls ticket.v2.TicketService.GetGroupBooking.
+func (c *ticketServiceClient) GetGroupBooking(ctx context.Context, req *connect.Request[v2.GetGroupBookingRequest]) (*connect.Response[v2.GetGroupBookingResponse], error) {
+	return c.getGroupBooking.CallUnary(ctx, req)
+}
+
+// CancelGroupBooking calls ticket.v2.TicketService.CancelGroupBooking.
+func (c *ticketServiceClient) CancelGroupBooking(ctx context.Context, req *connect.Request[v2.CancelGroupBookingRequest]) (*connect.Response[v2.CancelGroupBookingResponse], error) {
+	return c.cancelGroupBooking.CallUnary(ctx, req)
+}
+
+// TicketServiceHandler is an implementation of the ticket.v2.TicketService service.
+type TicketServiceHandler interface {
+	ListRoutes(context.Context, *connect.Request[v2.ListRoutesRequest]) (*connect.Response[v2.ListRoutesResponse], error)
+	ListDepartures(context.Context, *connect.Request[v2.ListDeparturesRequest]) (*connect.Response[v2.ListDeparturesResponse], error)
+	GetQuote(context.Context, *connect.Request[v2.GetQuoteRequest]) (*connect.Response[v2.GetQuoteResponse], error)
+	GetSeatMap(context.Context, *connect.Request[v2.GetSeatMapRequest]) (*connect.Response[v2.GetSeatMapResponse], error)
+	WatchSeats(context.Context, *connect.Request[v2.WatchSeatsRequest], *connect.ServerStream[v2.WatchSeatsResponse]) error
+	CreateBooking(context.Context, *connect.Request[v2.CreateBookingRequest]) (*connect.Response[v2.CreateBookingResponse], error)
+	HoldSeat(context.Context, *connect.Request[v2.HoldSeatRequest]) (*connect.Response[v2.HoldSeatResponse], error)
+	ConfirmHold(context.Context, *connect.Request[v2.ConfirmHoldRequest]) (*connect.Response[v2.ConfirmHoldResponse], error)
+	GetBooking(context.Context, *connect.Request[v2.GetBookingRequest]) (*connect.Response[v2.GetBookingResponse], error)
+	ListBookings(context.Context, *connect.Request[v2.ListBookingsRequest]) (*connect.Response[v2.ListBookingsResponse], error)
+	CancelBooking(context.Context, *connect.Request[v2.CancelBookingRequest]) (*connect.Response[v2.CancelBookingResponse], error)
+	ChangeSeat(context.Context, *connect.Request[v2.ChangeSeatRequest]) (*connect.Response[v2.ChangeSeatResponse], error)
+	SwapSeats(context.Context, *connect.Request[v2.SwapSeatsRequest]) (*connect.Response[v2.SwapSeatsResponse], error)
+	CreateGroupBooking(context.Context, *connect.Request[v2.CreateGroupBookingRequest]) (*connect.Response[v2.CreateGroupBookingResponse], error)
+	GetGroupBooking(context.Context, *connect.Request[v2.GetGroupBookingRequest]) (*connect.Response[v2.GetGroupBookingResponse], error)
+	CancelGroupBooking(context.Context, *connect.Request[v2.CancelGroupBookingRequest]) (*connect.Response[v2.CancelGroupBookingResponse], error)
+}
+
+// NewTicketServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTicketServiceHandler(svc TicketServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ticketServiceMethods := v2.File_ticket_v2_ticket_proto.Services().ByName("TicketService").Methods()
+	ticketServiceListRoutesHandler := connect.NewUnaryHandler(
+		TicketServiceListRoutesProcedure,
+		svc.ListRoutes,
+		connect.WithSchema(ticketServiceMethods.ByName("ListRoutes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceListDeparturesHandler := connect.NewUnaryHandler(
+		TicketServiceListDeparturesProcedure,
+		svc.ListDepartures,
+		connect.WithSchema(ticketServiceMethods.ByName("ListDepartures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetQuoteHandler := connect.NewUnaryHandler(
+		TicketServiceGetQuoteProcedure,
+		svc.GetQuote,
+		connect.WithSchema(ticketServiceMethods.ByName("GetQuote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetSeatMapHandler := connect.NewUnaryHandler(
+		TicketServiceGetSeatMapProcedure,
+		svc.GetSeatMap,
+		connect.WithSchema(ticketServiceMethods.ByName("GetSeatMap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceWatchSeatsHandler := connect.NewServerStreamHandler(
+		TicketServiceWatchSeatsProcedure,
+		svc.WatchSeats,
+		connect.WithSchema(ticketServiceMethods.ByName("WatchSeats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceCreateBookingHandler := connect.NewUnaryHandler(
+		TicketServiceCreateBookingProcedure,
+		svc.CreateBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("CreateBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceHoldSeatHandler := connect.NewUnaryHandler(
+		TicketServiceHoldSeatProcedure,
+		svc.HoldSeat,
+		connect.WithSchema(ticketServiceMethods.ByName("HoldSeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceConfirmHoldHandler := connect.NewUnaryHandler(
+		TicketServiceConfirmHoldProcedure,
+		svc.ConfirmHold,
+		connect.WithSchema(ticketServiceMethods.ByName("ConfirmHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetBookingHandler := connect.NewUnaryHandler(
+		TicketServiceGetBookingProcedure,
+		svc.GetBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("GetBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceListBookingsHandler := connect.NewUnaryHandler(
+		TicketServiceListBookingsProcedure,
+		svc.ListBookings,
+		connect.WithSchema(ticketServiceMethods.ByName("ListBookings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceCancelBookingHandler := connect.NewUnaryHandler(
+		TicketServiceCancelBookingProcedure,
+		svc.CancelBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("CancelBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceChangeSeatHandler := connect.NewUnaryHandler(
+		TicketServiceChangeSeatProcedure,
+		svc.ChangeSeat,
+		connect.WithSchema(ticketServiceMethods.ByName("ChangeSeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceSwapSeatsHandler := connect.NewUnaryHandler(
+		TicketServiceSwapSeatsProcedure,
+		svc.SwapSeats,
+		connect.WithSchema(ticketServiceMethods.ByName("SwapSeats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceCreateGroupBookingHandler := connect.NewUnaryHandler(
+		TicketServiceCreateGroupBookingProcedure,
+		svc.CreateGroupBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("CreateGroupBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceGetGroupBookingHandler := connect.NewUnaryHandler(
+		TicketServiceGetGroupBookingProcedure,
+		svc.GetGroupBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("GetGroupBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ticketServiceCancelGroupBookingHandler := connect.NewUnaryHandler(
+		TicketServiceCancelGroupBookingProcedure,
+		svc.CancelGroupBooking,
+		connect.WithSchema(ticketServiceMethods.ByName("CancelGroupBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ticket.v2.TicketService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TicketServiceListRoutesProcedure:
+			ticketServiceListRoutesHandler.ServeHTTP(w, r)
+		case TicketServiceListDeparturesProcedure:
+			ticketServiceListDeparturesHandler.ServeHTTP(w, r)
+		case TicketServiceGetQuoteProcedure:
+			ticketServiceGetQuoteHandler.ServeHTTP(w, r)
+		case TicketServiceGetSeatMapProcedure:
+			ticketServiceGetSeatMapHandler.ServeHTTP(w, r)
+		case TicketServiceWatchSeatsProcedure:
+			ticketServiceWatchSeatsHandler.ServeHTTP(w, r)
+		case TicketServiceCreateBookingProcedure:
+			ticketServiceCreateBookingHandler.ServeHTTP(w, r)
+		case TicketServiceHoldSeatProcedure:
+			ticketServiceHoldSeatHandler.ServeHTTP(w, r)
+		case TicketServiceConfirmHoldProcedure:
+			ticketServiceConfirmHoldHandler.ServeHTTP(w, r)
+		case TicketServiceGetBookingProcedure:
+			ticketServiceGetBookingHandler.ServeHTTP(w, r)
+		case TicketServiceListBookingsProcedure:
+			ticketServiceListBookingsHandler.ServeHTTP(w, r)
+		case TicketServiceCancelBookingProcedure:
+			ticketServiceCancelBookingHandler.ServeHTTP(w, r)
+		case TicketServiceChangeSeatProcedure:
+			ticketServiceChangeSeatHandler.ServeHTTP(w, r)
+		case TicketServiceSwapSeatsProcedure:
+			ticketServiceSwapSeatsHandler.ServeHTTP(w, r)
+		case TicketServiceCreateGroupBookingProcedure:
+			ticketServiceCreateGroupBookingHandler.ServeHTTP(w, r)
+		case TicketServiceGetGroupBookingProcedure:
+			ticketServiceGetGroupBookingHandler.ServeHTTP(w, r)
+		case TicketServiceCancelGroupBookingProcedure:
+			ticketServiceCancelGroupBookingHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTicketServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTicketServiceHandler struct{}
+
+func (UnimplementedTicketServiceHandler) ListRoutes(context.Context, *connect.Request[v2.ListRoutesRequest]) (*connect.Response[v2.ListRoutesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.ListRoutes is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ListDepartures(context.Context, *connect.Request[v2.ListDeparturesRequest]) (*connect.Response[v2.ListDeparturesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.ListDepartures is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetQuote(context.Context, *connect.Request[v2.GetQuoteRequest]) (*connect.Response[v2.GetQuoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.GetQuote is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetSeatMap(context.Context, *connect.Request[v2.GetSeatMapRequest]) (*connect.Response[v2.GetSeatMapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.GetSeatMap is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) WatchSeats(context.Context, *connect.Request[v2.WatchSeatsRequest], *connect.ServerStream[v2.WatchSeatsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.WatchSeats is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) CreateBooking(context.Context, *connect.Request[v2.CreateBookingRequest]) (*connect.Response[v2.CreateBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.CreateBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) HoldSeat(context.Context, *connect.Request[v2.HoldSeatRequest]) (*connect.Response[v2.HoldSeatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.HoldSeat is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ConfirmHold(context.Context, *connect.Request[v2.ConfirmHoldRequest]) (*connect.Response[v2.ConfirmHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.ConfirmHold is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetBooking(context.Context, *connect.Request[v2.GetBookingRequest]) (*connect.Response[v2.GetBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.GetBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ListBookings(context.Context, *connect.Request[v2.ListBookingsRequest]) (*connect.Response[v2.ListBookingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.ListBookings is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) CancelBooking(context.Context, *connect.Request[v2.CancelBookingRequest]) (*connect.Response[v2.CancelBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.CancelBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) ChangeSeat(context.Context, *connect.Request[v2.ChangeSeatRequest]) (*connect.Response[v2.ChangeSeatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.ChangeSeat is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) SwapSeats(context.Context, *connect.Request[v2.SwapSeatsRequest]) (*connect.Response[v2.SwapSeatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.SwapSeats is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) CreateGroupBooking(context.Context, *connect.Request[v2.CreateGroupBookingRequest]) (*connect.Response[v2.CreateGroupBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.CreateGroupBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) GetGroupBooking(context.Context, *connect.Request[v2.GetGroupBookingRequest]) (*connect.Response[v2.GetGroupBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.GetGroupBooking is not implemented"))
+}
+
+func (UnimplementedTicketServiceHandler) CancelGroupBooking(context.Context, *connect.Request[v2.CancelGroupBookingRequest]) (*connect.Response[v2.CancelGroupBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ticket.v2.TicketService.CancelGroupBooking is not implemented"))
+}
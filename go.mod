@@ -3,13 +3,19 @@ module github.com/chandankumar2517/TrainTicketingSystem
 go 1.23.2
 
 require (
+	connectrpc.com/connect v1.18.1
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.23.0
+	github.com/rs/cors v1.11.1
+	go.etcd.io/bbolt v1.3.11
+	google.golang.org/genproto/googleapis/api v0.0.0-20241021214115-324edc3d5d38
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20241021214115-324edc3d5d38
 	google.golang.org/grpc v1.67.1
 	google.golang.org/protobuf v1.35.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	golang.org/x/net v0.28.0 // indirect
 	golang.org/x/sys v0.24.0 // indirect
-	golang.org/x/text v0.17.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240814211410-ddb44dafa142 // indirect
+	golang.org/x/text v0.19.0 // indirect
 )
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+// groupOrder is a request for seats for several passengers travelling
+// together, as either version of the API takes it
+type groupOrder struct {
+	departureID string
+	from, to    string
+	travelClass string
+	passengers  []*pb.User
+	payment     string // Payment token, paying for the whole group
+	price       *Money // The total fare the client agreed to, if any
+}
+
+// groupBooking is the bookings of a group purchase, in seat order
+type groupBooking struct {
+	id       string
+	receipts []*pb.Receipt
+	total    Money // Sum of the prices of the bookings
+}
+
+// purchaseGroup books every passenger of a group on the same journey, or
+// none of them, under one group reference paid for with one payment
+func (s *server) purchaseGroup(ctx context.Context, order groupOrder) (*groupBooking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	allocations, err := s.allocateGroup(order.departureID, order.from, order.to, order.travelClass, len(order.passengers))
+	if err != nil {
+		return nil, err
+	}
+	total := Money{Currency: s.catalogue.Currency}
+	for _, a := range allocations {
+		total.Amount += a.fare.Amount
+	}
+	if err := checkPrice(order.price, total); err != nil {
+		return nil, err
+	}
+
+	groupID, err := s.newBookingID()
+	if err != nil {
+		return nil, err
+	}
+	transactionID, err := s.authorize(ctx, order.payment, total)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]*pb.Receipt, 0, len(allocations))
+	for i, a := range allocations {
+		receipt := &pb.Receipt{
+			User:          order.passengers[i],
+			PaymentStatus: pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+			TransactionId: transactionID,
+			GroupId:       groupID,
+		}
+		if err := s.book(a, receipt); err != nil {
+			s.abandonPurchase(ctx, receipts, transactionID, total)
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	if err := s.payments.Capture(ctx, transactionID); err != nil {
+		s.abandonPurchase(ctx, receipts, transactionID, total)
+		return nil, paymentError(err)
+	}
+	for _, receipt := range receipts {
+		receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_CAPTURED
+	}
+	for _, receipt := range receipts {
+		if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+			// A group is paid for whole, so a booking that cannot be stored
+			// as paid gives the payment back for every booking
+			s.abandonPurchase(ctx, receipts, transactionID, total)
+			return nil, err
+		}
+	}
+
+	return &groupBooking{id: groupID, receipts: receipts, total: total}, nil
+}
+
+// getGroup returns the bookings of a group purchase
+func (s *server) getGroup(groupID string) (*groupBooking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.group(groupID)
+}
+
+// cancelGroup cancels every booking of a group purchase that is not
+// cancelled yet, refunding each under the refund policy. It returns the
+// group and the sum of the refunds, or nil if the group was not paid for.
+func (s *server) cancelGroup(ctx context.Context, groupID string) (*groupBooking, *Money, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, err := s.group(groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cancelled, paid bool
+	refund := Money{Currency: s.catalogue.Currency}
+	for _, receipt := range group.receipts {
+		if receipt.Status == pb.BookingStatus_BOOKING_STATUS_CANCELLED {
+			continue
+		}
+		if err := s.cancelBooking(ctx, receipt); err != nil {
+			return nil, nil, err
+		}
+		cancelled = true
+		if receipt.Refund != nil {
+			amount, err := MoneyFromProto(receipt.Refund)
+			if err != nil {
+				return nil, nil, err
+			}
+			refund.Amount += amount.Amount
+			paid = true
+		}
+	}
+	if !cancelled {
+		return nil, nil, preconditionError(preconditionCancelled, groupID, "group is already cancelled")
+	}
+
+	if !paid {
+		return group, nil, nil
+	}
+	return group, &refund, nil
+}
+
+// Helper function to load the bookings of a group, ordered by seat, and
+// add up their prices
+func (s *server) group(groupID string) (*groupBooking, error) {
+	ids, ok := s.groups[groupID]
+	if !ok {
+		return nil, notFoundError(resourceGroup, groupID, "group not found")
+	}
+
+	group := &groupBooking{id: groupID, total: Money{Currency: s.catalogue.Currency}}
+	for _, id := range ids {
+		receipt, err := s.store.Receipt(id)
+		if err != nil {
+			return nil, err
+		}
+		price, err := MoneyFromProto(receipt.Price)
+		if err != nil {
+			return nil, err
+		}
+		group.receipts = append(group.receipts, receipt)
+		group.total.Amount += price.Amount
+	}
+
+	// Every booking of a group is on the same departure
+	layout := s.trains[group.receipts[0].DepartureId].layout
+	order := func(receipt *pb.Receipt) int {
+		seat, _ := layout.Seat(receipt.Seat)
+		return layout.Order(seat)
+	}
+	sort.SliceStable(group.receipts, func(i, j int) bool {
+		return order(group.receipts[i]) < order(group.receipts[j])
+	})
+	return group, nil
+}
+
+// Helper function to pick seats for n passengers travelling together on a
+// journey, from the sections of the requested travel class
+func (s *server) allocateGroup(departureID, from, to, travelClass string, n int) ([]*allocation, error) {
+	departure, inv, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, journey, err := resolveJourney(departure, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if travelClass != "" && !inv.layout.HasClass(travelClass) {
+		return nil, invalidArgumentError("travel_class", "no section of the departure has this travel class")
+	}
+
+	var sections []*SectionLayout
+	vacant := make(map[string][]Seat)
+	for _, section := range inv.layout.Sections {
+		if travelClass != "" && section.Class != travelClass {
+			continue
+		}
+		sections = append(sections, section)
+		vacant[section.Name] = inv.vacantSeats(section, journey)
+	}
+	seats := groupSeats(sections, vacant, n)
+	if seats == nil {
+		return nil, exhaustedError(resourceDeparture, departure.ID, fmt.Sprintf("fewer than %d seats available", n))
+	}
+
+	allocations := make([]*allocation, len(seats))
+	for i, seat := range seats {
+		section, _ := inv.layout.Section(seat.Section)
+		fare, err := s.catalogue.Price(departure, section, journey)
+		if err != nil {
+			return nil, err
+		}
+		allocations[i] = &allocation{
+			departure: departure,
+			inv:       inv,
+			from:      from,
+			to:        to,
+			journey:   journey,
+			section:   section,
+			seat:      seat,
+			fare:      fare,
+		}
+	}
+	return allocations, nil
+}
+
+// groupSeats picks n of the vacant seats of sections, keeping the group as
+// close together as it can: side by side in one row, then consecutive seats
+// of one section, then any seats of one section, then the first vacant
+// seats of every section. Returns nil if there are fewer than n seats.
+func groupSeats(sections []*SectionLayout, vacant map[string][]Seat, n int) []Seat {
+	for _, together := range []func(prev, next Seat) bool{
+		func(prev, next Seat) bool { return next.Index == prev.Index+1 && next.Row == prev.Row },
+		func(prev, next Seat) bool { return next.Index == prev.Index+1 },
+		func(prev, next Seat) bool { return true },
+	} {
+		for _, section := range sections {
+			if run := seatRun(vacant[section.Name], n, together); run != nil {
+				return run
+			}
+		}
+	}
+
+	var seats []Seat
+	for _, section := range sections {
+		for _, seat := range vacant[section.Name] {
+			if len(seats) < n {
+				seats = append(seats, seat)
+			}
+		}
+	}
+	if len(seats) < n {
+		return nil
+	}
+	return seats
+}
+
+// seatRun returns the first n seats in a row of seats where every seat is
+// together with the one before it, or nil
+func seatRun(seats []Seat, n int, together func(prev, next Seat) bool) []Seat {
+	start := 0
+	for i := range seats {
+		if i > start && !together(seats[i-1], seats[i]) {
+			start = i
+		}
+		if i-start+1 == n {
+			return seats[start : i+1]
+		}
+	}
+	return nil
+}
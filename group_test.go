@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+func TestPurchaseGroupGivesBackPaymentOnFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		failAt        int  // Store write that fails
+		refundRefused bool // Whether the gateway refuses to give the payment back
+		wantPayment   pb.PaymentStatus
+		wantRefunded  bool // Whether the captured payment is refunded in full
+	}{
+		{
+			name:        "booking a passenger",
+			failAt:      2,
+			wantPayment: pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED,
+		},
+		{
+			name:         "storing the first booking as paid",
+			failAt:       3,
+			wantPayment:  pb.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+			wantRefunded: true,
+		},
+		{
+			name:         "storing the last booking as paid",
+			failAt:       4,
+			wantPayment:  pb.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+			wantRefunded: true,
+		},
+		{
+			name:          "refund refused",
+			failAt:        3,
+			refundRefused: true,
+			wantPayment:   pb.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := &failingStore{Store: NewMemoryStore(), failAt: tt.failAt}
+			fake := NewFakeGateway().(*fakeGateway)
+			var gateway PaymentGateway = fake
+			if tt.refundRefused {
+				gateway = failingGateway{fake}
+			}
+			srv, err := NewServer(store, testCatalogue(t, &Fare{Route: "R", Base: 20 * nanosPerUnit}), gateway, logNotifier{}, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			order := groupOrder{departureID: "D"}
+			for _, email := range []string{"a@example.com", "b@example.com"} {
+				order.passengers = append(order.passengers, &pb.User{FirstName: "Test", LastName: "User", Email: email})
+			}
+			if _, err := srv.purchaseGroup(ctx, order); err == nil {
+				t.Fatal("purchaseGroup() succeeded despite the failed write")
+			}
+
+			for transactionID, payment := range fake.payments {
+				switch {
+				case !payment.captured:
+					t.Errorf("authorization %s not released", transactionID)
+				case tt.wantRefunded && payment.refunded != payment.amount.Amount:
+					t.Errorf("payment %s refunded %d of %d", transactionID, payment.refunded, payment.amount.Amount)
+				case !tt.wantRefunded && payment.refunded != 0:
+					t.Errorf("payment %s refunded %d, want nothing", transactionID, payment.refunded)
+				}
+			}
+			receipts, err := store.Receipts()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, receipt := range receipts {
+				if receipt.Status != pb.BookingStatus_BOOKING_STATUS_CANCELLED || receipt.PaymentStatus != tt.wantPayment {
+					t.Errorf("booking %s left %s and %s, want cancelled and %s", receipt.BookingId, receipt.Status, receipt.PaymentStatus, tt.wantPayment)
+				}
+				if refunded := receipt.Refund != nil; refunded != tt.wantRefunded {
+					t.Errorf("booking %s records refund %v", receipt.BookingId, receipt.Refund)
+				}
+			}
+
+			// Every seat of the departure is free again
+			for _, email := range []string{"c@example.com", "d@example.com"} {
+				order.passengers = append(order.passengers, &pb.User{FirstName: "Test", LastName: "User", Email: email})
+			}
+			if _, err := srv.purchaseGroup(ctx, order); err != nil {
+				t.Errorf("purchaseGroup() of every seat error = %v", err)
+			}
+		})
+	}
+}
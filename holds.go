@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// holdSeat reserves a seat for the configured hold TTL without confirming
+// it, so the customer can be shown the seat and pay before ConfirmHold. The
+// payment, price and waitlist of the order are not used.
+func (s *server) holdSeat(order ticketOrder) (*pb.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, err := s.allocate(order.departureID, order.from, order.to, order.travelClass, order.preference)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &pb.Receipt{
+		User:          order.user,
+		Status:        pb.BookingStatus_BOOKING_STATUS_HELD,
+		HoldExpiresAt: timestamppb.New(time.Now().Add(s.opts.HoldTTL)),
+	}
+	if err := s.book(a, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// confirmHold takes payment for a live hold at the held fare, checked
+// against price if given, and turns it into a confirmed booking
+func (s *server) confirmHold(ctx context.Context, bookingID, payment string, price *Money) (*pb.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.booking(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != pb.BookingStatus_BOOKING_STATUS_HELD {
+		return nil, preconditionError(preconditionNotHeld, bookingID, "booking is not held")
+	}
+
+	// A hold the reaper has not got to yet is still expired
+	if time.Now().After(s.holds[receipt.BookingId]) {
+		if err := s.cancelBooking(ctx, receipt); err != nil {
+			return nil, err
+		}
+		return nil, preconditionError(preconditionHoldExpired, bookingID, "hold has expired")
+	}
+
+	fare, err := MoneyFromProto(receipt.Price)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPrice(price, fare); err != nil {
+		return nil, err
+	}
+
+	// Pay as for a purchase: authorize, store the confirmed booking, capture
+	transactionID, err := s.authorize(ctx, payment, fare)
+	if err != nil {
+		return nil, err
+	}
+	receipt.Status = pb.BookingStatus_BOOKING_STATUS_CONFIRMED
+	receipt.HoldExpiresAt = nil
+	receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+	receipt.TransactionId = transactionID
+	if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+		s.abandonPurchase(ctx, nil, transactionID, fare)
+		return nil, err
+	}
+	delete(s.holds, receipt.BookingId)
+	seat, _ := s.trains[receipt.DepartureId].layout.Seat(receipt.Seat)
+	journey, _ := s.journey(receipt)
+	s.seatChanged(receipt.DepartureId, seat, journey)
+
+	if err := s.capture(ctx, receipt); err != nil {
+		s.abandonPurchase(ctx, []*pb.Receipt{receipt}, transactionID, fare)
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// reapHolds releases expired holds every interval until ctx is done
+func (s *server) reapHolds(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.releaseExpiredHolds(now)
+		}
+	}
+}
+
+// releaseExpiredHolds cancels every hold that expired before now
+func (s *server) releaseExpiredHolds(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for bookingID, expiry := range s.holds {
+		if now.Before(expiry) {
+			continue
+		}
+		receipt, err := s.store.Receipt(bookingID)
+		if err == nil {
+			err = s.cancelBooking(context.Background(), receipt)
+		}
+		if err != nil {
+			log.Printf("Failed to release hold %s: %v", bookingID, err)
+			continue
+		}
+		log.Printf("Released expired hold %s on seat %s", bookingID, receipt.Seat)
+	}
+}
+
+// reapInterval is how often expired holds are looked for: often enough that
+// a seat is not kept much past its TTL, without spinning on short TTLs
+func reapInterval(ttl time.Duration) time.Duration {
+	return min(max(ttl/10, time.Second), time.Minute)
+}
@@ -0,0 +1,128 @@
+package main
+
+// legs is the range of route legs a ticket covers, from leg from up to but
+// not including leg to; leg i runs from station i to station i+1
+type legs struct {
+	from, to int
+}
+
+// inventory tracks which booking occupies each seat of one departure on
+// every leg of its route, so a seat can be sold again once a passenger
+// has left the train
+type inventory struct {
+	layout  *Layout
+	legs    int                   // Number of legs of the route
+	seats   map[string][][]string // Booking IDs by section, seat and leg, "" when vacant
+	blocked map[string]bool       // Labels of seats not on sale
+}
+
+// newInventory creates an empty inventory with every seat of layout vacant
+// on each of the route's legs, apart from the blocked seats
+func newInventory(layout *Layout, legCount int, blocked []string) *inventory {
+	inv := &inventory{
+		layout:  layout,
+		legs:    legCount,
+		seats:   make(map[string][][]string),
+		blocked: make(map[string]bool),
+	}
+	for _, label := range blocked {
+		inv.blocked[label] = true
+	}
+	for _, section := range layout.Sections {
+		seats := make([][]string, section.Capacity())
+		for i := range seats {
+			seats[i] = make([]string, legCount)
+		}
+		inv.seats[section.Name] = seats
+	}
+	return inv
+}
+
+// allLegs is the journey from the first station of the route to the last
+func (inv *inventory) allLegs() legs {
+	return legs{from: 0, to: inv.legs}
+}
+
+// vacant reports whether seat is on sale and free on every leg of journey
+func (inv *inventory) vacant(seat Seat, journey legs) bool {
+	return !inv.blocked[seat.Label] && inv.occupant(seat, journey) == ""
+}
+
+// vacantSeats returns the seats of section that are vacant on every leg of
+// journey, in allocation order
+func (inv *inventory) vacantSeats(section *SectionLayout, journey legs) []Seat {
+	var seats []Seat
+	for _, seat := range section.Seats() {
+		if inv.vacant(seat, journey) {
+			seats = append(seats, seat)
+		}
+	}
+	return seats
+}
+
+// occupant returns a booking holding seat on any leg of journey, or "" if
+// the seat is vacant for the whole journey
+func (inv *inventory) occupant(seat Seat, journey legs) string {
+	for _, bookingID := range inv.seats[seat.Section][seat.Index][journey.from:journey.to] {
+		if bookingID != "" {
+			return bookingID
+		}
+	}
+	return ""
+}
+
+// vacantExcept reports whether seat is free on every leg of journey but for
+// legs held by bookingID
+func (inv *inventory) vacantExcept(seat Seat, journey legs, bookingID string) bool {
+	for _, occupant := range inv.seats[seat.Section][seat.Index][journey.from:journey.to] {
+		if occupant != "" && occupant != bookingID {
+			return false
+		}
+	}
+	return true
+}
+
+// occupants returns the distinct bookings holding seat on the legs of
+// journey, in leg order
+func (inv *inventory) occupants(seat Seat, journey legs) []string {
+	var bookingIDs []string
+	for _, bookingID := range inv.seats[seat.Section][seat.Index][journey.from:journey.to] {
+		if bookingID != "" && (len(bookingIDs) == 0 || bookingIDs[len(bookingIDs)-1] != bookingID) {
+			bookingIDs = append(bookingIDs, bookingID)
+		}
+	}
+	return bookingIDs
+}
+
+// occupy assigns seat to a booking on every leg of journey
+func (inv *inventory) occupy(seat Seat, journey legs, bookingID string) {
+	occupied := inv.seats[seat.Section][seat.Index]
+	for leg := journey.from; leg < journey.to; leg++ {
+		occupied[leg] = bookingID
+	}
+}
+
+// vacate marks seat as vacant on every leg of journey
+func (inv *inventory) vacate(seat Seat, journey legs) {
+	inv.occupy(seat, journey, "")
+}
+
+// available counts the seats that are vacant on every leg of journey
+func (inv *inventory) available(journey legs) int {
+	var count int
+	for _, section := range inv.layout.Sections {
+		count += inv.availableIn(section, journey)
+	}
+	return count
+}
+
+// availableIn counts the seats of section that are vacant on every leg of journey
+func (inv *inventory) availableIn(section *SectionLayout, journey legs) int {
+	var count int
+	for _, seat := range section.Seats() {
+		if inv.vacant(seat, journey) {
+			count++
+		}
+	}
+	return count
+}
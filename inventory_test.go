@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInventoryLegs(t *testing.T) {
+	layout := &Layout{Sections: []*SectionLayout{{Name: "A", Rows: 1, SeatsPerRow: 1}}}
+	if err := layout.build(); err != nil {
+		t.Fatal(err)
+	}
+	seat, _ := layout.Seat("A1")
+
+	// Three legs, with the seat sold on the first two to different bookings
+	inv := newInventory(layout, 3, nil)
+	inv.occupy(seat, legs{from: 0, to: 1}, "first")
+	inv.occupy(seat, legs{from: 1, to: 2}, "second")
+
+	tests := []struct {
+		journey       legs
+		wantVacant    bool
+		wantOccupants string
+		except        string // Booking whose own legs do not count
+		wantExcept    bool
+	}{
+		{journey: legs{from: 0, to: 1}, wantOccupants: "first", except: "first", wantExcept: true},
+		{journey: legs{from: 1, to: 2}, wantOccupants: "second", except: "first"},
+		{journey: legs{from: 2, to: 3}, wantVacant: true, except: "first", wantExcept: true},
+		{journey: legs{from: 0, to: 2}, wantOccupants: "first second", except: "first"},
+		{journey: legs{from: 1, to: 3}, wantOccupants: "second", except: "second", wantExcept: true},
+		{journey: legs{from: 0, to: 3}, wantOccupants: "first second", except: "second"},
+	}
+	for _, tt := range tests {
+		if got := inv.vacant(seat, tt.journey); got != tt.wantVacant {
+			t.Errorf("vacant(%+v) = %v, want %v", tt.journey, got, tt.wantVacant)
+		}
+		if got := strings.Join(inv.occupants(seat, tt.journey), " "); got != tt.wantOccupants {
+			t.Errorf("occupants(%+v) = %q, want %q", tt.journey, got, tt.wantOccupants)
+		}
+		if got := inv.vacantExcept(seat, tt.journey, tt.except); got != tt.wantExcept {
+			t.Errorf("vacantExcept(%+v, %s) = %v, want %v", tt.journey, tt.except, got, tt.wantExcept)
+		}
+	}
+
+	inv.vacate(seat, legs{from: 0, to: 1})
+	if !inv.vacant(seat, legs{from: 0, to: 1}) || inv.vacant(seat, legs{from: 0, to: 2}) {
+		t.Error("vacate() freed the wrong legs")
+	}
+}
+
+// Helper function to build a catalogue with one departure calling at
+// London, Lille and Paris, with a single seat for sale
+func multiStopCatalogue(t *testing.T) *Catalogue {
+	t.Helper()
+	catalogue := &Catalogue{
+		Layouts:    map[string]*Layout{"coach": {Sections: []*SectionLayout{{Name: "A", Rows: 1, SeatsPerRow: 1}}}},
+		Routes:     []*Route{{ID: "R", Name: "Test", Stations: []string{"London", "Lille", "Paris"}}},
+		Departures: []*Departure{{ID: "D", Route: "R", Layout: "coach"}},
+		Fares:      []*Fare{{Route: "R", Base: 20 * nanosPerUnit}},
+	}
+	if err := catalogue.build(); err != nil {
+		t.Fatal(err)
+	}
+	return catalogue
+}
+
+func TestDepartureLegs(t *testing.T) {
+	departure, _ := multiStopCatalogue(t).Departure("D")
+	tests := []struct {
+		from, to string
+		want     legs
+		wantOK   bool
+	}{
+		{from: "London", to: "Lille", want: legs{from: 0, to: 1}, wantOK: true},
+		{from: "Lille", to: "Paris", want: legs{from: 1, to: 2}, wantOK: true},
+		{from: "London", to: "Paris", want: legs{from: 0, to: 2}, wantOK: true},
+		{from: "Paris", to: "London"},
+		{from: "Lille", to: "Lille"},
+		{from: "London", to: "Madrid"},
+	}
+	for _, tt := range tests {
+		got, ok := departure.Legs(tt.from, tt.to)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Legs(%s, %s) = %+v, %v, want %+v, %v", tt.from, tt.to, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestSeatSoldPerLeg(t *testing.T) {
+	catalogue := multiStopCatalogue(t)
+	store := NewMemoryStore()
+	srv, err := NewServer(store, catalogue, NewFakeGateway(), logNotifier{}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		from, to string
+		wantCode codes.Code
+	}{
+		{from: "London", to: "Lille", wantCode: codes.OK},
+		{from: "Lille", to: "Paris", wantCode: codes.OK},
+		{from: "London", to: "Paris", wantCode: codes.ResourceExhausted},
+		{from: "Lille", to: "Paris", wantCode: codes.ResourceExhausted},
+	}
+	purchase := func(srv *server, from, to string) error {
+		_, err := srv.purchaseTicket(context.Background(), ticketOrder{
+			departureID: "D",
+			from:        from,
+			to:          to,
+			user:        &pb.User{FirstName: "Test", LastName: "User", Email: strings.ToLower(from) + "@example.com"},
+		})
+		return err
+	}
+	for _, step := range steps {
+		if err := purchase(srv, step.from, step.to); status.Code(err) != step.wantCode {
+			t.Errorf("%s to %s: error = %v, want %s", step.from, step.to, err, step.wantCode)
+		}
+	}
+
+	// A restart rebuilds the occupancy of each leg from the stored bookings
+	restarted, err := NewServer(store, catalogue, NewFakeGateway(), logNotifier{}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, journey := range [][2]string{{"London", "Lille"}, {"Lille", "Paris"}, {"London", "Paris"}} {
+		if err := purchase(restarted, journey[0], journey[1]); status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("%s to %s after a restart: error = %v, want ResourceExhausted", journey[0], journey[1], err)
+		}
+	}
+}
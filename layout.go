@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// DefaultClass is the travel class of sections that do not name one
+const DefaultClass = "standard"
+
+// Seat numbering schemes of a section
+const (
+	NumberingSequential = "sequential" // A1, A2, A3, ...
+	NumberingRow        = "row"        // A-1A, A-1B, A-2A, ...
+)
+
+// Layout describes the sections of a coach and how their seats are numbered
+type Layout struct {
+	Sections []*SectionLayout `json:"sections"`
+
+	seats map[string]Seat // Every seat by label
+}
+
+// SectionLayout is one named section of a coach
+type SectionLayout struct {
+	Name        string `json:"name"`
+	Rows        int    `json:"rows"`
+	SeatsPerRow int    `json:"seats_per_row"`
+	Numbering   string `json:"numbering,omitempty"` // Defaults to sequential
+	Class       string `json:"class,omitempty"`     // Travel class used to price seats, defaults to standard
+
+	seats []Seat // In allocation order
+}
+
+// Seat is one seat of a section
+type Seat struct {
+	Label   string
+	Section string
+	Index   int  // Position within the section, in allocation order
+	Row     int  // 1-based row number
+	Column  int  // 0-based position within the row
+	Window  bool // First or last seat of its row
+	Aisle   bool // Next to the aisle, which runs down the middle of the row
+}
+
+// DefaultLayout is the original train: sections A and B with two seats each
+func DefaultLayout() *Layout {
+	layout := &Layout{Sections: []*SectionLayout{
+		{Name: "A", Rows: 1, SeatsPerRow: 2},
+		{Name: "B", Rows: 1, SeatsPerRow: 2},
+	}}
+	if err := layout.build(); err != nil {
+		panic(err)
+	}
+	return layout
+}
+
+// LoadLayout reads a JSON layout file
+func LoadLayout(path string) (*Layout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var layout Layout
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return nil, fmt.Errorf("parse layout %s: %w", path, err)
+	}
+	if err := layout.build(); err != nil {
+		return nil, fmt.Errorf("layout %s: %w", path, err)
+	}
+	return &layout, nil
+}
+
+// build validates the layout and numbers its seats
+func (l *Layout) build() error {
+	if len(l.Sections) == 0 {
+		return errors.New("no sections")
+	}
+
+	l.seats = make(map[string]Seat)
+	names := make(map[string]bool)
+	for _, section := range l.Sections {
+		if section.Name == "" {
+			return errors.New("section without a name")
+		}
+		if names[section.Name] {
+			return fmt.Errorf("duplicate section %q", section.Name)
+		}
+		names[section.Name] = true
+		if section.Rows <= 0 || section.SeatsPerRow <= 0 {
+			return fmt.Errorf("section %q must have at least one row and one seat per row", section.Name)
+		}
+		if section.Numbering == "" {
+			section.Numbering = NumberingSequential
+		}
+		if section.Class == "" {
+			section.Class = DefaultClass
+		}
+
+		section.seats = make([]Seat, 0, section.Capacity())
+		for i := 0; i < section.Capacity(); i++ {
+			seat := Seat{
+				Section: section.Name,
+				Index:   i,
+				Row:     i/section.SeatsPerRow + 1,
+				Column:  i % section.SeatsPerRow,
+			}
+			last := section.SeatsPerRow - 1
+			seat.Window = seat.Column == 0 || seat.Column == last
+			seat.Aisle = seat.Column == last/2 || seat.Column == section.SeatsPerRow/2
+			switch section.Numbering {
+			case NumberingSequential:
+				seat.Label = fmt.Sprintf("%s%d", section.Name, i+1)
+			case NumberingRow:
+				seat.Label = fmt.Sprintf("%s-%d%c", section.Name, seat.Row, 'A'+seat.Column)
+			default:
+				return fmt.Errorf("section %q has unknown numbering %q", section.Name, section.Numbering)
+			}
+			if _, exists := l.seats[seat.Label]; exists {
+				return fmt.Errorf("seat %s is numbered twice", seat.Label)
+			}
+			section.seats = append(section.seats, seat)
+			l.seats[seat.Label] = seat
+		}
+	}
+	return nil
+}
+
+// Section returns the section named name
+func (l *Layout) Section(name string) (*SectionLayout, bool) {
+	for _, section := range l.Sections {
+		if section.Name == name {
+			return section, true
+		}
+	}
+	return nil, false
+}
+
+// HasClass reports whether any section of the layout is of travel class class
+func (l *Layout) HasClass(class string) bool {
+	for _, section := range l.Sections {
+		if section.Class == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Seat returns the seat labelled label
+func (l *Layout) Seat(label string) (Seat, bool) {
+	seat, ok := l.seats[label]
+	return seat, ok
+}
+
+// Order returns the position of seat in allocation order across every
+// section of the layout
+func (l *Layout) Order(seat Seat) int {
+	var order int
+	for _, section := range l.Sections {
+		if section.Name == seat.Section {
+			return order + seat.Index
+		}
+		order += section.Capacity()
+	}
+	return order
+}
+
+// Capacity is the number of seats in the section
+func (s *SectionLayout) Capacity() int {
+	return s.Rows * s.SeatsPerRow
+}
+
+// Seats returns the seats of the section in allocation order
+func (s *SectionLayout) Seats() []Seat {
+	return s.seats
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLayoutNumbering(t *testing.T) {
+	tests := []struct {
+		name       string
+		section    *SectionLayout
+		wantLabels string
+	}{
+		{
+			name:       "sequential by default",
+			section:    &SectionLayout{Name: "A", Rows: 2, SeatsPerRow: 2},
+			wantLabels: "A1 A2 A3 A4",
+		},
+		{
+			name:       "sequential",
+			section:    &SectionLayout{Name: "B", Rows: 1, SeatsPerRow: 3, Numbering: NumberingSequential},
+			wantLabels: "B1 B2 B3",
+		},
+		{
+			name:       "row",
+			section:    &SectionLayout{Name: "C", Rows: 2, SeatsPerRow: 3, Numbering: NumberingRow},
+			wantLabels: "C-1A C-1B C-1C C-2A C-2B C-2C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := &Layout{Sections: []*SectionLayout{tt.section}}
+			if err := layout.build(); err != nil {
+				t.Fatal(err)
+			}
+			var labels []string
+			for i, seat := range tt.section.seats {
+				labels = append(labels, seat.Label)
+				wantRow, wantColumn := i/tt.section.SeatsPerRow+1, i%tt.section.SeatsPerRow
+				if seat.Section != tt.section.Name || seat.Index != i || seat.Row != wantRow || seat.Column != wantColumn {
+					t.Errorf("seat %s is %+v, want section %s, index %d, row %d and column %d",
+						seat.Label, seat, tt.section.Name, i, wantRow, wantColumn)
+				}
+				if got, ok := layout.Seat(seat.Label); !ok || got != seat {
+					t.Errorf("Seat(%q) = %+v, %v", seat.Label, got, ok)
+				}
+			}
+			if got := strings.Join(labels, " "); got != tt.wantLabels {
+				t.Errorf("labels = %s, want %s", got, tt.wantLabels)
+			}
+		})
+	}
+}
+
+func TestLayoutBuildErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections []*SectionLayout
+	}{
+		{name: "no sections"},
+		{name: "section without a name", sections: []*SectionLayout{{Rows: 1, SeatsPerRow: 1}}},
+		{name: "duplicate section", sections: []*SectionLayout{
+			{Name: "A", Rows: 1, SeatsPerRow: 1},
+			{Name: "A", Rows: 1, SeatsPerRow: 1},
+		}},
+		{name: "no rows", sections: []*SectionLayout{{Name: "A", SeatsPerRow: 2}}},
+		{name: "no seats per row", sections: []*SectionLayout{{Name: "A", Rows: 2}}},
+		{name: "unknown numbering", sections: []*SectionLayout{{Name: "A", Rows: 1, SeatsPerRow: 1, Numbering: "zigzag"}}},
+		{name: "seat numbered twice", sections: []*SectionLayout{
+			{Name: "A", Rows: 1, SeatsPerRow: 11},
+			{Name: "A1", Rows: 1, SeatsPerRow: 1},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := &Layout{Sections: tt.sections}
+			if err := layout.build(); err == nil {
+				t.Error("build() succeeded, want an error")
+			}
+		})
+	}
+}
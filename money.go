@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+// DefaultCurrency is the currency of catalogues that do not name one
+const DefaultCurrency = "GBP"
+
+const (
+	nanosPerUnit = 1_000_000_000
+	nanosPerCent = nanosPerUnit / 100
+)
+
+// Amount is an exact decimal quantity of a currency, counted in billionths
+// of a unit. In JSON it is written as a number or a string, e.g. 12.5 or "12.50".
+type Amount int64
+
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// ParseAmount parses a decimal such as "12", "12.5" or "0.075" without
+// going through floating point
+func ParseAmount(text string) (Amount, error) {
+	if !decimalPattern.MatchString(text) {
+		return 0, fmt.Errorf("invalid amount %q", text)
+	}
+	rat, _ := new(big.Rat).SetString(text)
+	nanos := new(big.Rat).Mul(rat, new(big.Rat).SetInt64(nanosPerUnit))
+	if !nanos.IsInt() || !nanos.Num().IsInt64() {
+		return 0, fmt.Errorf("amount %q has too many digits", text)
+	}
+	return Amount(nanos.Num().Int64()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or string
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	text := string(bytes.Trim(data, `"`))
+	amount, err := ParseAmount(text)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// MarshalJSON writes the amount as a decimal string
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + a.String() + `"`), nil
+}
+
+// RoundToCent rounds half away from zero to a whole cent
+func (a Amount) RoundToCent() Amount {
+	cents := int64(a) / nanosPerCent
+	rest := int64(a) % nanosPerCent
+	if rest >= nanosPerCent/2 {
+		cents++
+	} else if rest <= -nanosPerCent/2 {
+		cents--
+	}
+	return Amount(cents * nanosPerCent)
+}
+
+// String formats the amount with at least two decimals, e.g. "12.50"
+func (a Amount) String() string {
+	sign := ""
+	n := int64(a)
+	if n < 0 {
+		sign, n = "-", -n
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", n%nanosPerUnit), "0")
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+	return fmt.Sprintf("%s%d.%s", sign, n/nanosPerUnit, fraction)
+}
+
+// Money is an amount in a given currency
+type Money struct {
+	Currency string // ISO 4217 code
+	Amount   Amount
+}
+
+// String formats the money as e.g. "GBP 12.50"
+func (m Money) String() string {
+	return m.Currency + " " + m.Amount.String()
+}
+
+// Proto converts the money to its wire form
+func (m Money) Proto() *pb.Money {
+	return &pb.Money{
+		CurrencyCode: m.Currency,
+		Units:        int64(m.Amount) / nanosPerUnit,
+		Nanos:        int32(int64(m.Amount) % nanosPerUnit),
+	}
+}
+
+// Float32 is the legacy, approximate form of the money used by price_paid
+func (m Money) Float32() float32 {
+	return float32(float64(m.Amount) / nanosPerUnit)
+}
+
+// MoneyFromProto converts wire money, rejecting out of range nanos and
+// nanos whose sign differs from the units
+func MoneyFromProto(money *pb.Money) (Money, error) {
+	if money.Nanos <= -nanosPerUnit || money.Nanos >= nanosPerUnit {
+		return Money{}, errors.New("nanos must be between -999,999,999 and 999,999,999")
+	}
+	if (money.Units > 0 && money.Nanos < 0) || (money.Units < 0 && money.Nanos > 0) {
+		return Money{}, errors.New("units and nanos must have the same sign")
+	}
+	if money.Units > math.MaxInt64/nanosPerUnit || money.Units < math.MinInt64/nanosPerUnit {
+		return Money{}, errors.New("amount is too large")
+	}
+	// The largest units leave room for fewer nanos than a whole unit
+	amount := money.Units * nanosPerUnit
+	if (money.Nanos > 0 && amount > math.MaxInt64-int64(money.Nanos)) || (money.Nanos < 0 && amount < math.MinInt64-int64(money.Nanos)) {
+		return Money{}, errors.New("amount is too large")
+	}
+	return Money{
+		Currency: money.CurrencyCode,
+		Amount:   Amount(amount + int64(money.Nanos)),
+	}, nil
+}
+
+// MoneyFromLegacy converts a legacy float price, which carries no currency,
+// into currency, rounded to the cent
+func MoneyFromLegacy(price float32, currency string) Money {
+	cents := math.Round(float64(price) * 100)
+	return Money{Currency: currency, Amount: Amount(int64(cents) * nanosPerCent)}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    Amount
+		wantErr bool
+	}{
+		{text: "12", want: 12 * nanosPerUnit},
+		{text: "12.5", want: 12*nanosPerUnit + 500_000_000},
+		{text: "12.50", want: 12*nanosPerUnit + 500_000_000},
+		{text: "0.075", want: 75_000_000},
+		{text: "0.000000001", want: 1},
+		{text: "-3.10", want: -3*nanosPerUnit - 100_000_000},
+		{text: "9223372036.854775807", want: math.MaxInt64},
+		{text: "0.0000000001", wantErr: true},
+		{text: "9223372036.854775808", wantErr: true},
+		{text: "", wantErr: true},
+		{text: "12.", wantErr: true},
+		{text: ".5", wantErr: true},
+		{text: "+1", wantErr: true},
+		{text: "1e3", wantErr: true},
+		{text: "1,50", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseAmount(tt.text)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseAmount(%q) = %d, want an error", tt.text, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseAmount(%q) = %d, %v, want %d", tt.text, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundToCent(t *testing.T) {
+	tests := []struct {
+		amount string
+		want   string
+	}{
+		{amount: "12.34", want: "12.34"},
+		{amount: "12.345", want: "12.35"},
+		{amount: "12.344999999", want: "12.34"},
+		{amount: "0.005", want: "0.01"},
+		{amount: "0.004999999", want: "0.00"},
+		{amount: "-12.345", want: "-12.35"},
+		{amount: "-12.344999999", want: "-12.34"},
+		{amount: "-0.004", want: "0.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			amount, err := ParseAmount(tt.amount)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := amount.RoundToCent().String(); got != tt.want {
+				t.Errorf("%s rounded to the cent = %s, want %s", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		money   *pb.Money
+		want    Amount
+		wantErr bool
+	}{
+		{name: "units and nanos", money: &pb.Money{Units: 12, Nanos: 500_000_000}, want: 12*nanosPerUnit + 500_000_000},
+		{name: "nanos only", money: &pb.Money{Nanos: -75_000_000}, want: -75_000_000},
+		{name: "negative", money: &pb.Money{Units: -1, Nanos: -500_000_000}, want: -nanosPerUnit - 500_000_000},
+		{name: "zero", money: &pb.Money{}, want: 0},
+		{name: "largest", money: &pb.Money{Units: math.MaxInt64 / nanosPerUnit, Nanos: math.MaxInt64 % nanosPerUnit}, want: math.MaxInt64},
+		{name: "smallest", money: &pb.Money{Units: math.MinInt64 / nanosPerUnit, Nanos: math.MinInt64 % nanosPerUnit}, want: math.MinInt64},
+		{name: "nanos out of range", money: &pb.Money{Units: 1, Nanos: nanosPerUnit}, wantErr: true},
+		{name: "negative nanos out of range", money: &pb.Money{Nanos: -nanosPerUnit}, wantErr: true},
+		{name: "signs differ", money: &pb.Money{Units: 1, Nanos: -1}, wantErr: true},
+		{name: "units too large", money: &pb.Money{Units: math.MaxInt64/nanosPerUnit + 1}, wantErr: true},
+		{name: "nanos overflow the largest units", money: &pb.Money{Units: math.MaxInt64 / nanosPerUnit, Nanos: math.MaxInt64%nanosPerUnit + 1}, wantErr: true},
+		{name: "nanos overflow the smallest units", money: &pb.Money{Units: math.MinInt64 / nanosPerUnit, Nanos: math.MinInt64%nanosPerUnit - 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.money.CurrencyCode = "GBP"
+			got, err := MoneyFromProto(tt.money)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("MoneyFromProto(%v) = %s, want an error", tt.money, got)
+				}
+				return
+			}
+			if err != nil || got != (Money{Currency: "GBP", Amount: tt.want}) {
+				t.Errorf("MoneyFromProto(%v) = %s, %v, want GBP %s", tt.money, got, err, tt.want)
+			}
+			if back := got.Proto(); back.Units != tt.money.Units || back.Nanos != tt.money.Nanos {
+				t.Errorf("Proto() = %v, want %v", back, tt.money)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+// Notifier tells users about changes to their bookings that they did not
+// make themselves, such as a seat allocated from the waitlist
+type Notifier interface {
+	Notify(ctx context.Context, user *pb.User, message string) error
+}
+
+// OpenNotifier returns the notifier named kind
+func OpenNotifier(kind string) (Notifier, error) {
+	switch kind {
+	case "log":
+		return logNotifier{}, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier %q", kind)
+	}
+}
+
+// logNotifier writes notifications to the server log, for development
+type logNotifier struct{}
+
+func (logNotifier) Notify(ctx context.Context, user *pb.User, message string) error {
+	log.Printf("Notify %s: %s", user.Email, message)
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrPaymentDeclined is returned by a gateway when the payment method is refused
+var ErrPaymentDeclined = errors.New("payment declined")
+
+// PaymentGateway takes payments through a payment provider. A purchase is
+// authorized before the booking is stored and captured once it is, and the
+// payment of a cancelled booking is refunded.
+//
+// The server calls the gateway while it holds the lock over every booking,
+// so that a seat cannot be sold twice while it is being paid for. Every
+// other RPC, reads included, waits for the call, so a gateway must answer
+// quickly and give up once ctx is done rather than wait on a slow provider.
+type PaymentGateway interface {
+	// Authorize reserves amount on the payment method identified by token
+	// and returns the provider's transaction reference
+	Authorize(ctx context.Context, token string, amount Money) (string, error)
+	// Capture collects the authorized amount of a transaction
+	Capture(ctx context.Context, transactionID string) error
+	// Refund pays back amount of a captured transaction, or releases the
+	// authorization of one that was never captured
+	Refund(ctx context.Context, transactionID string, amount Money) error
+}
+
+// OpenGateway returns the payment gateway named kind
+func OpenGateway(kind string) (PaymentGateway, error) {
+	switch kind {
+	case "fake":
+		return NewFakeGateway(), nil
+	default:
+		return nil, fmt.Errorf("unknown payment gateway %q", kind)
+	}
+}
+
+// FakeDeclinedToken is the payment token the fake gateway always declines
+const FakeDeclinedToken = "tok_declined"
+
+// fakeGateway is an in-process gateway for development and tests. It
+// approves every payment except those made with FakeDeclinedToken.
+type fakeGateway struct {
+	mu       sync.Mutex
+	payments map[string]*fakePayment // By transaction ID
+}
+
+type fakePayment struct {
+	amount   Money
+	captured bool
+	refunded Amount
+}
+
+// NewFakeGateway creates a gateway that keeps its transactions in memory
+func NewFakeGateway() PaymentGateway {
+	return &fakeGateway{payments: make(map[string]*fakePayment)}
+}
+
+func (g *fakeGateway) Authorize(ctx context.Context, token string, amount Money) (string, error) {
+	if token == FakeDeclinedToken {
+		return "", ErrPaymentDeclined
+	}
+
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	transactionID := "fake_" + hex.EncodeToString(b[:])
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.payments[transactionID] = &fakePayment{amount: amount}
+	log.Printf("Payment %s: authorized %s", transactionID, amount)
+	return transactionID, nil
+}
+
+func (g *fakeGateway) Capture(ctx context.Context, transactionID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[transactionID]
+	if !ok {
+		return fmt.Errorf("unknown transaction %s", transactionID)
+	}
+	if payment.captured {
+		return fmt.Errorf("transaction %s is already captured", transactionID)
+	}
+	payment.captured = true
+	log.Printf("Payment %s: captured %s", transactionID, payment.amount)
+	return nil
+}
+
+func (g *fakeGateway) Refund(ctx context.Context, transactionID string, amount Money) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Transactions do not survive a restart, unlike the bookings they paid
+	// for, so refunds of earlier fake payments are simply accepted
+	payment, ok := g.payments[transactionID]
+	if !ok && strings.HasPrefix(transactionID, "fake_") {
+		log.Printf("Payment %s: refunded %s", transactionID, amount)
+		return nil
+	} else if !ok {
+		return fmt.Errorf("unknown transaction %s", transactionID)
+	}
+	if amount.Currency != payment.amount.Currency || payment.refunded+amount.Amount > payment.amount.Amount {
+		return fmt.Errorf("cannot refund %s of transaction %s", amount, transactionID)
+	}
+	if !payment.captured {
+		delete(g.payments, transactionID)
+		log.Printf("Payment %s: released authorization", transactionID)
+		return nil
+	}
+	payment.refunded += amount.Amount
+	log.Printf("Payment %s: refunded %s", transactionID, amount)
+	return nil
+}
+
+// paymentError converts a gateway failure into a gRPC status: declined
+// payments are the client's to fix, anything else is the provider's
+func paymentError(err error) error {
+	if errors.Is(err, ErrPaymentDeclined) {
+		return preconditionError(preconditionPaymentDeclined, "payment", err.Error())
+	}
+	return status.Errorf(codes.Unavailable, "payment gateway: %v", err)
+}
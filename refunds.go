@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RefundRule pays back Percent of the price of bookings cancelled at least
+// Before ahead of departure
+type RefundRule struct {
+	Before  Duration `json:"before"`
+	Percent int64    `json:"percent"`
+}
+
+// Duration is a time.Duration written in JSON as a string such as "48h"
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(text)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// MarshalJSON writes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// buildRefundPolicy validates the refund rules and orders them from the
+// longest notice to the shortest
+func (c *Catalogue) buildRefundPolicy() error {
+	for _, rule := range c.RefundPolicy {
+		if rule.Before < 0 {
+			return fmt.Errorf("refund rule before %s is negative", time.Duration(rule.Before))
+		}
+		if rule.Percent < 0 || rule.Percent > 100 {
+			return fmt.Errorf("refund rule before %s must refund between 0 and 100 percent", time.Duration(rule.Before))
+		}
+	}
+	sort.SliceStable(c.RefundPolicy, func(i, j int) bool {
+		return c.RefundPolicy[i].Before > c.RefundPolicy[j].Before
+	})
+	return nil
+}
+
+// Refund returns how much of price is paid back when a booking on departure
+// is cancelled at the given time: the percentage of the first rule whose
+// notice is met, nothing if none is, and everything without a policy.
+// Departures without a time are treated as far in the future.
+func (c *Catalogue) Refund(departure *Departure, price Money, at time.Time) Money {
+	if len(c.RefundPolicy) == 0 {
+		return price
+	}
+	for _, rule := range c.RefundPolicy {
+		if departure.DepartsAt.IsZero() || departure.DepartsAt.Sub(at) >= time.Duration(rule.Before) {
+			refund := Amount(int64(price.Amount) * rule.Percent / 100)
+			return Money{Currency: price.Currency, Amount: refund.RoundToCent()}
+		}
+	}
+	return Money{Currency: price.Currency}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// seatChangeOrder asks to move a booking to a seat, or to any seat of a
+// section, as either version of the API takes it
+type seatChangeOrder struct {
+	bookingID string
+	seat      string
+	section   string
+	payment   string // Payment token, paying the difference for a dearer seat
+	price     *Money // The new fare the client agreed to, if any
+}
+
+// seatChange is a booking moved to another seat, with the fare difference
+// charged or refunded if it was paid for
+type seatChange struct {
+	receipt  *pb.Receipt
+	charged  *Money
+	refunded *Money
+}
+
+// changeSeat moves a booking to a seat that may be priced differently, such
+// as an upgrade to first class. A confirmed booking pays the difference with
+// the order's payment token when the new fare is higher and gets it back
+// when it is lower. A held booking is only repriced, and pays the new fare
+// on ConfirmHold.
+func (s *server) changeSeat(ctx context.Context, order seatChangeOrder) (*seatChange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if (order.seat == "") == (order.section == "") {
+		return nil, invalidArgumentError("new_seat", "give either a new seat or a section")
+	}
+	receipt, err := s.booking(order.bookingID)
+	if err != nil {
+		return nil, err
+	}
+	switch receipt.Status {
+	case pb.BookingStatus_BOOKING_STATUS_CANCELLED:
+		return nil, preconditionError(preconditionCancelled, order.bookingID, "booking is cancelled")
+	case pb.BookingStatus_BOOKING_STATUS_WAITLISTED:
+		return nil, preconditionError(preconditionWaitlisted, order.bookingID, "booking has no seat yet")
+	}
+	if receipt.Seat == order.seat {
+		return nil, preconditionError(preconditionSameSeat, order.seat, "user is already seated in the requested seat")
+	}
+
+	a, err := s.allocate(receipt.DepartureId, receipt.From, receipt.To, "", seatPreference{seat: order.seat, section: order.section})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPrice(order.price, a.fare); err != nil {
+		return nil, err
+	}
+	price, err := MoneyFromProto(receipt.Price)
+	if err != nil {
+		return nil, err
+	}
+	if price.Currency != a.fare.Currency {
+		return nil, fmt.Errorf("booking %s is priced in %s but the fare is in %s", receipt.BookingId, price.Currency, a.fare.Currency)
+	}
+
+	// Take any higher fare before the booking changes
+	before := proto.Clone(receipt).(*pb.Receipt)
+	change := &seatChange{}
+	var refund []refundPart
+	difference := Money{Currency: price.Currency, Amount: a.fare.Amount - price.Amount}
+	if receipt.Status == pb.BookingStatus_BOOKING_STATUS_CONFIRMED {
+		switch {
+		case difference.Amount > 0:
+			transactionID, err := s.authorize(ctx, order.payment, difference)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.payments.Capture(ctx, transactionID); err != nil {
+				if err := s.releasePayment(ctx, transactionID, difference); err != nil {
+					log.Printf("Failed to release payment %s: %v", transactionID, err)
+				}
+				return nil, paymentError(err)
+			}
+			receipt.FareAdjustments = append(receipt.FareAdjustments, &pb.FareAdjustment{
+				TransactionId: transactionID,
+				Amount:        difference.Proto(),
+				Refunded:      Money{Currency: difference.Currency}.Proto(),
+			})
+			if receipt.PaymentStatus == pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED {
+				// Bookings from before payments now have a payment to refund
+				receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_CAPTURED
+			}
+			change.charged = &difference
+		case difference.Amount < 0:
+			amount := Money{Currency: difference.Currency, Amount: -difference.Amount}
+			var uncovered Money
+			refund, uncovered, err = planRefund(receipt, amount)
+			if err != nil {
+				return nil, err
+			}
+			if uncovered.Amount > 0 {
+				// Bookings from before payments have nothing to refund it on
+				return nil, preconditionError(preconditionNotRefundable, order.bookingID,
+					fmt.Sprintf("%s of the fare difference was not paid through a payment that can be refunded", uncovered))
+			}
+			change.refunded = &amount
+		}
+	}
+
+	// Persist the receipt with the new seat and fare before touching the seat map
+	oldSeat, _ := a.inv.layout.Seat(receipt.Seat)
+	receipt.Seat = a.seat.Label
+	receipt.TravelClass = a.section.Class
+	receipt.Price = a.fare.Proto()
+	receipt.PricePaid = a.fare.Float32()
+	receipt.SwapWith, receipt.SwapSeat = "", "" // The offer was for the old seat
+	if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+		if change.charged != nil {
+			log.Printf("Failed to store the seat change of booking %s, releasing the %s charged for it: %v", receipt.BookingId, difference, err)
+			transactionID := receipt.FareAdjustments[len(receipt.FareAdjustments)-1].TransactionId
+			if err := s.releasePayment(ctx, transactionID, difference); err != nil {
+				log.Printf("Failed to release payment %s, settle it by hand: %v", transactionID, err)
+			}
+		}
+		return nil, err
+	}
+
+	// Pay back a lower fare once the booking records it, putting the booking
+	// back if the refund fails
+	if err := s.issueRefund(ctx, receipt.BookingId, refund); err != nil {
+		if err := s.store.PutReceipt(before.BookingId, before); err != nil {
+			log.Printf("Failed to restore booking %s after its refund failed: %v", before.BookingId, err)
+		}
+		return nil, err
+	}
+
+	a.inv.vacate(oldSeat, a.journey)
+	a.inv.occupy(a.seat, a.journey, receipt.BookingId)
+	s.seatChanged(receipt.DepartureId, oldSeat, a.journey)
+	s.seatChanged(receipt.DepartureId, a.seat, a.journey)
+
+	change.receipt = receipt
+	return change, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangeSeatDowngradeRefund(t *testing.T) {
+	fares := []*Fare{
+		{Route: "R", Section: "Q", Base: 100 * nanosPerUnit},
+		{Route: "R", Section: "S", Base: 10 * nanosPerUnit},
+	}
+	tests := []struct {
+		name          string
+		transactionID string // Replaces the booking's payment; empty for a booking from before payments
+		wantCode      codes.Code
+		wantType      string
+	}{
+		{name: "paid booking", transactionID: "fake_paid", wantCode: codes.OK},
+		{name: "booking from before payments", wantCode: codes.FailedPrecondition, wantType: preconditionNotRefundable},
+		{name: "refund declined", transactionID: "unknown", wantCode: codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testServer(t, testCatalogue(t, fares...))
+			booking := bookSection(t, srv, "Q", "dear@example.com")
+			booking = getBooking(t, srv, booking.BookingId)
+			booking.TransactionId = tt.transactionID
+			if tt.transactionID == "" {
+				booking.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+			}
+			if err := srv.store.PutReceipt(booking.BookingId, booking); err != nil {
+				t.Fatal(err)
+			}
+
+			change, err := srv.changeSeat(context.Background(), seatChangeOrder{bookingID: booking.BookingId, section: "S"})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("changeSeat() error = %v, want %s", err, tt.wantCode)
+			}
+			got := getBooking(t, srv, booking.BookingId)
+			if tt.wantCode == codes.OK {
+				if change.refunded == nil || change.refunded.Amount != 90*nanosPerUnit {
+					t.Errorf("refunded = %v, want GBP 90.00", change.refunded)
+				}
+				if got.TravelClass != "standard" || got.Seat == booking.Seat {
+					t.Errorf("booking still in seat %s", got.Seat)
+				}
+				return
+			}
+
+			if got := preconditionType(err); got != tt.wantType {
+				t.Errorf("precondition violation = %q, want %q", got, tt.wantType)
+			}
+			if got.Seat != booking.Seat {
+				t.Errorf("seat = %s after a failed refund, want %s", got.Seat, booking.Seat)
+			}
+			if price, _ := MoneyFromProto(got.Price); price.Amount != 100*nanosPerUnit {
+				t.Errorf("price = %s after a failed refund, want GBP 100.00", price)
+			}
+		})
+	}
+}
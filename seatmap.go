@@ -0,0 +1,86 @@
+package main
+
+// seatStatus is whether a seat can be had for a journey
+type seatStatus int
+
+const (
+	seatFree seatStatus = iota
+	seatHeld
+	seatBooked
+	seatBlocked
+)
+
+// seatMap is every seat of a departure with its status on a journey, and
+// the feed cursor to follow changes to it from
+type seatMap struct {
+	departure *Departure
+	from, to  string
+	sections  []sectionMap
+	cursor    string
+}
+
+// sectionMap is the seats of one section and the fare of the journey in it
+type sectionMap struct {
+	section *SectionLayout
+	fare    Money
+	seats   []seatState
+}
+
+type seatState struct {
+	seat   Seat
+	status seatStatus
+}
+
+// seatMap returns every seat of a departure with its status on the
+// requested journey. Unlike the passenger lists it says nothing about who
+// sits where, so it can be shown to any customer.
+func (s *server) seatMap(departureID, from, to string) (*seatMap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	departure, inv, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, journey, err := resolveJourney(departure, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []sectionMap
+	for _, section := range inv.layout.Sections {
+		fare, err := s.catalogue.Price(departure, section, journey)
+		if err != nil {
+			return nil, err
+		}
+		seats := make([]seatState, 0, section.Capacity())
+		for _, seat := range section.Seats() {
+			seats = append(seats, seatState{seat: seat, status: s.seatStatus(inv, seat, journey)})
+		}
+		sections = append(sections, sectionMap{section: section, fare: fare, seats: seats})
+	}
+
+	return &seatMap{
+		departure: departure,
+		from:      from,
+		to:        to,
+		sections:  sections,
+		cursor:    s.feed.cursor(),
+	}, nil
+}
+
+// Helper function to tell whether a seat can be had for a journey. A seat
+// booked on any leg is booked, even if it is only held on another.
+func (s *server) seatStatus(inv *inventory, seat Seat, journey legs) seatStatus {
+	if inv.blocked[seat.Label] {
+		return seatBlocked
+	}
+	status := seatFree
+	for _, bookingID := range inv.occupants(seat, journey) {
+		if _, held := s.holds[bookingID]; !held {
+			return seatBooked
+		}
+		status = seatHeld
+	}
+	return status
+}
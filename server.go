@@ -3,271 +3,625 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
+	"time"
 
-	pb "github.com/chandankumar2517/TrainTicketingSystem/train_ticketing/train_ticketing" // Import the generated package
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1" // Import the generated package
+	pbv2 "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v2"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	seatLimitA = 2 // Seat capacity for section A
-	seatLimitB = 2 // Seat capacity for section B
-)
+// Options tunes the booking rules of the server
+type Options struct {
+	HoldTTL    time.Duration      // How long HoldSeat reserves a seat before it is released
+	Allocation AllocationStrategy // Picks seats for bookings without a specific seat; defaults to first-fit
+}
 
+// server is the booking system behind every version of the API. Bookings
+// are stored as ticket.v1 Receipt messages, the record type of the core;
+// the services of each API version translate their requests into calls on
+// the server and its records into their responses.
 type server struct {
-	pb.UnimplementedTicketServiceServer
-	mu    sync.Mutex
-	users map[string]pb.Receipt // Map of users by email to Receipt
-	seatA []string              // Allocated seats in section A
-	seatB []string              // Allocated seats in section B
+	mu        sync.Mutex // Held for the whole of each RPC, payment calls included
+	opts      Options
+	store     Store                 // Receipts by booking ID
+	payments  PaymentGateway        // Takes payment for purchases
+	notifier  Notifier              // Tells users about seats allocated from the waitlist
+	catalogue *Catalogue            // Routes and departures on sale
+	trains    map[string]*inventory // Seat inventory by departure ID
+	byEmail   map[string][]string   // Booking IDs by user email
+	holds     map[string]time.Time  // Expiry of held bookings by booking ID
+	waitlist  map[string][]string   // Waitlisted booking IDs by departure ID, in order of joining
+	groups    map[string][]string   // Booking IDs by group ID
+	feed      *seatFeed             // Recent seat changes for WatchSeats
 }
 
-// NewServer creates a new gRPC server instance
-func NewServer() *server {
-	return &server{
-		users: make(map[string]pb.Receipt),
-		seatA: []string{},
-		seatB: []string{},
+// NewServer creates a new gRPC server instance selling the departures of
+// catalogue, backed by store, taking payment through payments and sending
+// notifications through notifier, and rebuilds the seat allocation and
+// waitlist of every departure from the receipts already stored
+func NewServer(store Store, catalogue *Catalogue, payments PaymentGateway, notifier Notifier, opts Options) (*server, error) {
+	s := &server{
+		opts:      opts,
+		store:     store,
+		payments:  payments,
+		notifier:  notifier,
+		catalogue: catalogue,
+		trains:    make(map[string]*inventory),
+		byEmail:   make(map[string][]string),
+		holds:     make(map[string]time.Time),
+		waitlist:  make(map[string][]string),
+		groups:    make(map[string][]string),
+		feed:      newSeatFeed(),
 	}
-}
-
-// PurchaseTicket allocates a seat and returns a receipt
-func (s *server) PurchaseTicket(ctx context.Context, req *pb.PurchaseRequest) (*pb.Receipt, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	if _, exists := s.users[req.User.Email]; exists {
-		return nil, errors.New("user already purchased a ticket")
+	if s.opts.Allocation == nil {
+		s.opts.Allocation = firstFit{}
+	}
+	for _, departure := range catalogue.Departures {
+		s.trains[departure.ID] = newInventory(departure.layout, len(departure.route.Stations)-1, departure.Blocked)
 	}
 
-	// Allocate seat
-	var seat string
-	var allocated bool // To track if a seat has been allocated
+	receipts, err := store.Receipts()
+	if err != nil {
+		return nil, err
+	}
+	for _, receipt := range receipts {
+		s.byEmail[receipt.User.Email] = append(s.byEmail[receipt.User.Email], receipt.BookingId)
+		if receipt.GroupId != "" {
+			s.groups[receipt.GroupId] = append(s.groups[receipt.GroupId], receipt.BookingId)
+		}
+		if receipt.Status == pb.BookingStatus_BOOKING_STATUS_CANCELLED {
+			continue // History only: holds no seat, even on departures no longer on sale
+		}
 
-	// Check for vacant seats in section A
-	for i, email := range s.seatA {
-		if email == "" { // Vacant seat found
-			seat = fmt.Sprintf("A%d", i+1)
-			s.seatA[i] = req.User.Email
-			allocated = true
-			break
+		inv, ok := s.trains[receipt.DepartureId]
+		if !ok {
+			return nil, fmt.Errorf("booking %s is for departure %q which is not in the catalogue", receipt.BookingId, receipt.DepartureId)
+		}
+		if receipt.Status == pb.BookingStatus_BOOKING_STATUS_WAITLISTED {
+			s.waitlist[receipt.DepartureId] = append(s.waitlist[receipt.DepartureId], receipt.BookingId)
+			continue
+		}
+		seat, ok := inv.layout.Seat(receipt.Seat)
+		if !ok {
+			return nil, fmt.Errorf("booking %s holds seat %s which is not in the layout", receipt.BookingId, receipt.Seat)
+		}
+		journey, ok := s.journey(receipt)
+		if !ok {
+			return nil, fmt.Errorf("booking %s runs from %q to %q which is not on its route", receipt.BookingId, receipt.From, receipt.To)
+		}
+		inv.occupy(seat, journey, receipt.BookingId)
+		if receipt.Status == pb.BookingStatus_BOOKING_STATUS_HELD {
+			s.holds[receipt.BookingId] = receipt.HoldExpiresAt.AsTime() // Released by the reaper if already expired
 		}
-	}
 
-	// If no vacant seats in section A, check for vacant seats in section B
-	if !allocated {
-		for i, email := range s.seatB {
-			if email == "" { // Vacant seat found
-				seat = fmt.Sprintf("B%d", i+1)
-				s.seatB[i] = req.User.Email
-				allocated = true
-				break
+		// Receipts written before prices carried a currency only have price_paid
+		if receipt.Price == nil {
+			receipt.Price = MoneyFromLegacy(receipt.PricePaid, catalogue.Currency).Proto()
+			if err := store.PutReceipt(receipt.BookingId, receipt); err != nil {
+				return nil, err
 			}
 		}
 	}
+	if err := s.sortWaitlists(); err != nil {
+		return nil, err
+	}
 
-	// If no vacant seats, append to the end if space is available
-	if !allocated {
-		if len(s.seatA) < seatLimitA {
-			seat = fmt.Sprintf("A%d", len(s.seatA)+1)
-			s.seatA = append(s.seatA, req.User.Email)
-		} else if len(s.seatB) < seatLimitB {
-			seat = fmt.Sprintf("B%d", len(s.seatB)+1)
-			s.seatB = append(s.seatB, req.User.Email)
-		} else {
-			return nil, errors.New("no seats available")
-		}
+	return s, nil
+}
+
+// Helper function to look up a departure and its seat inventory; an empty id
+// selects the catalogue's default departure
+func (s *server) train(departureID string) (*Departure, *inventory, error) {
+	departure, ok := s.catalogue.Departure(departureID)
+	if !ok {
+		return nil, nil, notFoundError(resourceDeparture, departureID, "departure not found")
 	}
+	return departure, s.trains[departure.ID], nil
+}
 
-	receipt := &pb.Receipt{
-		From:      req.From,
-		To:        req.To,
-		User:      req.User,
-		PricePaid: req.PricePaid,
-		Seat:      seat,
+// Helper function to resolve the journey of a request on a departure.
+// Tickets run end to end unless the journey is given.
+func resolveJourney(departure *Departure, from, to string) (string, string, legs, error) {
+	if from == "" && to == "" {
+		from, to = departure.route.Stations[0], departure.route.Stations[len(departure.route.Stations)-1]
+	}
+	journey, ok := departure.Legs(from, to)
+	if !ok {
+		return "", "", legs{}, statusWithDetails(codes.InvalidArgument, fmt.Sprintf("departure %s does not run from %q to %q", departure.ID, from, to), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "from", Description: "must be a station of the route before to"},
+				{Field: "to", Description: "must be a station of the route after from"},
+			},
+		})
 	}
-	s.users[req.User.Email] = *receipt
+	return from, to, journey, nil
+}
 
-	return receipt, nil
+// Helper function to find the legs of its route a booking travels on
+func (s *server) journey(receipt *pb.Receipt) (legs, bool) {
+	departure, ok := s.catalogue.Departure(receipt.DepartureId)
+	if !ok {
+		return legs{}, false
+	}
+	return departure.Legs(receipt.From, receipt.To)
 }
 
-// Helper function to find a vacant seat in a section
-func (s *server) findVacantSeat(seats []string, section string) string {
-	for i, seat := range seats {
-		if seat == "" { // If seat is vacant, return the seat number
-			return fmt.Sprintf("%s%d", section, i+1)
+// Helper function to find the single confirmed booking a user holds on a
+// departure, for the RPCs that identify bookings by email
+func (s *server) userBooking(departureID, email string) (*pb.Receipt, error) {
+	departure, _, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *pb.Receipt
+	for _, id := range s.byEmail[email] {
+		receipt, err := s.store.Receipt(id)
+		if err != nil {
+			return nil, err
+		}
+		if receipt.DepartureId != departure.ID || receipt.Status != pb.BookingStatus_BOOKING_STATUS_CONFIRMED {
+			continue
+		}
+		if found != nil {
+			return nil, preconditionError(preconditionAmbiguousBooking, email, "user holds several bookings on this departure, use the booking ID")
 		}
+		found = receipt
 	}
-	return ""
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
 }
 
-// Helper function to get the index of a seat
-func (s *server) getSeatIndex(seat string) int {
-	var index int
-	fmt.Sscanf(seat[1:], "%d", &index) // Extract the seat number after the section letter
-	return index - 1
+// ticketOrder is a request for a seat on a journey, as either version of
+// the API takes it
+type ticketOrder struct {
+	departureID  string
+	from, to     string
+	travelClass  string
+	user         *pb.User
+	preference   seatPreference
+	payment      string // Payment token
+	price        *Money // The fare the client agreed to, if any
+	joinWaitlist bool   // Queue for a seat instead of failing when the train is full
 }
 
-// GetReceipt returns the receipt for a user by email
-func (s *server) GetReceipt(ctx context.Context, req *pb.ReceiptRequest) (*pb.Receipt, error) {
+// purchaseTicket allocates a seat on the requested departure and pays for it
+func (s *server) purchaseTicket(ctx context.Context, order ticketOrder) (*pb.Receipt, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	receipt, exists := s.users[req.Email]
-	if !exists {
-		return nil, errors.New("receipt not found for user")
+	a, err := s.allocate(order.departureID, order.from, order.to, order.travelClass, order.preference)
+	if status.Code(err) == codes.ResourceExhausted && order.joinWaitlist {
+		return s.joinWaitlist(order.departureID, order.from, order.to, order.travelClass, order.preference, order.user)
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The fare is computed here; a price sent by the client is only checked against it
+	if err := checkPrice(order.price, a.fare); err != nil {
+		return nil, err
 	}
 
-	return &receipt, nil
+	return s.purchase(ctx, a, order.user, order.payment)
+}
+
+// userReceipt returns the receipt of the booking a user holds on a departure
+func (s *server) userReceipt(departureID, email string) (*pb.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.userBooking(departureID, email)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError(resourceReceipt, email, "receipt not found for user")
+	}
+	return receipt, err
 }
 
-// GetAllocatedUsers returns users and their seats for a requested section
-func (s *server) GetAllocatedUsers(ctx context.Context, req *pb.SectionRequest) (*pb.UserList, error) {
+// sectionPassengers returns the confirmed bookings seated in a section of a
+// departure, in seat order, whatever part of the route they travel
+func (s *server) sectionPassengers(departureID, sectionName string) ([]*pb.Receipt, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	var users []*pb.UserSeatInfo
-	switch req.Section {
-	case "A":
-		for i, email := range s.seatA {
-			if email != "" { // Only add allocated seats
-				receipt := s.users[email]
-				users = append(users, &pb.UserSeatInfo{
-					User: receipt.User,
-					Seat: fmt.Sprintf("A%d", i+1),
-				})
+	_, inv, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+	section, ok := inv.layout.Section(sectionName)
+	if !ok {
+		return nil, invalidArgumentError("section", "invalid section")
+	}
+
+	var receipts []*pb.Receipt
+	for _, seat := range section.Seats() {
+		for _, bookingID := range inv.occupants(seat, inv.allLegs()) { // Only add allocated seats
+			receipt, err := s.store.Receipt(bookingID)
+			if err != nil {
+				return nil, err
 			}
-		}
-	case "B":
-		for i, email := range s.seatB {
-			if email != "" { // Only add allocated seats
-				receipt := s.users[email]
-				users = append(users, &pb.UserSeatInfo{
-					User: receipt.User,
-					Seat: fmt.Sprintf("B%d", i+1),
-				})
+			if receipt.Status != pb.BookingStatus_BOOKING_STATUS_CONFIRMED {
+				continue
 			}
+			receipts = append(receipts, receipt)
 		}
-	default:
-		return nil, errors.New("invalid section")
 	}
-
-	return &pb.UserList{UserSeats: users}, nil
+	return receipts, nil
 }
 
-// RemoveUser removes a user from the train system
-func (s *server) RemoveUser(ctx context.Context, req *pb.RemoveRequest) (*pb.Response, error) {
+// removeUser cancels the booking a user holds on a departure
+func (s *server) removeUser(ctx context.Context, departureID, email string) (*pb.Receipt, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	receipt, exists := s.users[req.Email]
-	if !exists {
-		return nil, errors.New("user not found")
+	receipt, err := s.userBooking(departureID, email)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFoundError(resourceReceipt, email, "user not found")
+	} else if err != nil {
+		return nil, err
 	}
 
-	// Remove seat assignment based on section (A or B)
-	if receipt.Seat[0] == 'A' {
-		s.vacateSeat(s.seatA, req.Email) // Update seatA list
-	} else if receipt.Seat[0] == 'B' {
-		s.vacateSeat(s.seatB, req.Email) // Update seatB list
+	if err := s.cancelBooking(ctx, receipt); err != nil {
+		return nil, err
 	}
-
-	// Finally, remove the user from the users map
-	delete(s.users, req.Email)
-
-	return &pb.Response{Message: "User removed successfully."}, nil
+	return receipt, nil
 }
 
-// ModifySeat modifies the seat of an existing user if the new seat is available
-func (s *server) ModifySeat(ctx context.Context, req *pb.ModifyRequest) (*pb.Response, error) {
+// modifyUserSeat moves the booking a user holds on a departure to another
+// seat if the new seat is available
+func (s *server) modifyUserSeat(departureID, email, newSeat string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// Check if the user exists
-	receipt, exists := s.users[req.Email]
-	if !exists {
-		return nil, errors.New("user not found")
+	receipt, err := s.userBooking(departureID, email)
+	if errors.Is(err, ErrNotFound) {
+		return notFoundError(resourceReceipt, email, "user not found")
+	} else if err != nil {
+		return err
 	}
 
-	// If the user is requesting the same seat they are currently seated in, no modification is needed
-	if receipt.Seat == req.NewSeat {
-		return nil, errors.New("user is already seated in the requested seat")
-	}
-
-	var sectionSeats []string
-	var seatLimit int
-
-	// Determine the seat section (A or B) based on the requested seat
-	if req.NewSeat[0] == 'A' {
-		sectionSeats = s.seatA
-		seatLimit = seatLimitA
-	} else if req.NewSeat[0] == 'B' {
-		sectionSeats = s.seatB
-		seatLimit = seatLimitB
-	} else {
-		return nil, errors.New("invalid seat section")
-	}
-
-	// Check if the new seat is within valid seat range and not taken
-	seatIndex := -1
-	for i := 0; i < seatLimit; i++ {
-		currentSeat := fmt.Sprintf("%c%d", req.NewSeat[0], i+1)
-		if currentSeat == req.NewSeat {
-			seatIndex = i
-			if sectionSeats[i] != "" && sectionSeats[i] != req.Email {
-				return nil, errors.New("the requested seat is already taken")
-			}
-			break
+	return s.moveBooking(receipt, newSeat)
+}
+
+// Helper function to cancel a booking, refund its payment under the
+// catalogue's refund policy and vacate its seat, which is offered to the
+// waitlist. The cancelled booking is kept for the user's history, with the
+// amount refunded if it was paid for.
+func (s *server) cancelBooking(ctx context.Context, receipt *pb.Receipt) error {
+	now := time.Now()
+	if receipt.Status == pb.BookingStatus_BOOKING_STATUS_WAITLISTED {
+		// Nothing was allocated or paid for yet
+		receipt.Status = pb.BookingStatus_BOOKING_STATUS_CANCELLED
+		receipt.CancelledAt = timestamppb.New(now)
+		if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+			return err
 		}
+		s.leaveWaitlist(receipt)
+		return nil
 	}
 
-	// If seatIndex is -1, it means the requested seat is invalid or beyond seat limit
-	if seatIndex == -1 {
-		return nil, errors.New("invalid seat number")
+	price, err := MoneyFromProto(receipt.Price)
+	if err != nil {
+		return err
 	}
 
-	// Vacate the current seat
-	if receipt.Seat[0] == 'A' {
-		s.vacateSeat(s.seatA, req.Email)
-	} else if receipt.Seat[0] == 'B' {
-		s.vacateSeat(s.seatB, req.Email)
+	refund := Money{Currency: price.Currency}
+	switch receipt.PaymentStatus {
+	case pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED:
+		// Never captured: the whole authorization is given back
+		refund = price
+	case pb.PaymentStatus_PAYMENT_STATUS_CAPTURED:
+		departure, _ := s.catalogue.Departure(receipt.DepartureId)
+		refund = s.catalogue.Refund(departure, price, now)
+	}
+	if refund.Amount > 0 {
+		if err := s.refundPayment(ctx, receipt, refund); err != nil {
+			return err
+		}
+		if refund == price {
+			receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_REFUNDED
+		} else {
+			receipt.PaymentStatus = pb.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED
+		}
+	}
+	if receipt.PaymentStatus != pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED {
+		receipt.Refund = refund.Proto()
 	}
 
-	// Assign the user to the new seat
-	sectionSeats[seatIndex] = req.Email
+	return s.closeBooking(ctx, receipt, now)
+}
 
-	// Update the user's receipt with the new seat
-	receipt.Seat = req.NewSeat
-	s.users[req.Email] = receipt
+// Helper function to store a booking as cancelled at now and give up its
+// seat, once its payment is settled
+func (s *server) closeBooking(ctx context.Context, receipt *pb.Receipt, now time.Time) error {
+	// Store the cancellation before vacating so a failed write leaves the seat allocated
+	receipt.Status = pb.BookingStatus_BOOKING_STATUS_CANCELLED
+	receipt.CancelledAt = timestamppb.New(now)
+	receipt.HoldExpiresAt = nil
+	if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+		return err
+	}
 
-	return &pb.Response{Message: "Seat modified successfully."}, nil
+	inv := s.trains[receipt.DepartureId]
+	seat, _ := inv.layout.Seat(receipt.Seat)
+	journey, _ := s.journey(receipt)
+	inv.vacate(seat, journey)
+	delete(s.holds, receipt.BookingId)
+	s.seatChanged(receipt.DepartureId, seat, journey)
+	s.promoteWaitlist(ctx, receipt.DepartureId)
+	return nil
 }
 
-// Utility function to vacate the current seat
-func (s *server) vacateSeat(seats []string, email string) {
-	for i, e := range seats {
-		if e == email {
-			seats[i] = "" // Mark the seat as vacant
-			break
+// Helper function to move a booking to another seat of its departure
+func (s *server) moveBooking(receipt *pb.Receipt, newSeatLabel string) error {
+	// If the user is requesting the same seat they are currently seated in, no modification is needed
+	if receipt.Seat == newSeatLabel {
+		return preconditionError(preconditionSameSeat, newSeatLabel, "user is already seated in the requested seat")
+	}
+
+	// Check if the new seat exists in the layout and is not taken on any leg of the journey
+	inv := s.trains[receipt.DepartureId]
+	journey, _ := s.journey(receipt)
+	newSeat, ok := inv.layout.Seat(newSeatLabel)
+	if !ok {
+		return invalidArgumentError("new_seat", "invalid seat number")
+	}
+	if inv.blocked[newSeat.Label] {
+		return invalidArgumentError("new_seat", "seat is not on sale")
+	}
+	if inv.occupant(newSeat, journey) != "" {
+		return alreadyExistsError(resourceSeat, newSeat.Label, "the requested seat is already taken")
+	}
+
+	// Seats priced differently are changed with ChangeSeat, which settles the difference
+	oldSeat, _ := inv.layout.Seat(receipt.Seat)
+	if err := s.checkSameFare(receipt, journey, oldSeat, newSeat); err != nil {
+		return err
+	}
+
+	// Persist the receipt with the new seat before touching the seat map
+	receipt.Seat = newSeat.Label
+	receipt.SwapWith, receipt.SwapSeat = "", "" // The offer was for the old seat
+	if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+		return err
+	}
+
+	// Move the booking from the current seat to the new one
+	inv.vacate(oldSeat, journey)
+	inv.occupy(newSeat, journey, receipt.BookingId)
+	s.seatChanged(receipt.DepartureId, oldSeat, journey)
+	s.seatChanged(receipt.DepartureId, newSeat, journey)
+	return nil
+}
+
+// Helper function to check that a booking's journey costs the same in
+// newSeat as in oldSeat, as seats can only be exchanged freely at the same
+// fare; other changes go through ChangeSeat, which settles the difference
+func (s *server) checkSameFare(receipt *pb.Receipt, journey legs, oldSeat, newSeat Seat) error {
+	if oldSeat.Section == newSeat.Section {
+		return nil
+	}
+	inv := s.trains[receipt.DepartureId]
+	departure, _ := s.catalogue.Departure(receipt.DepartureId)
+	oldSection, _ := inv.layout.Section(oldSeat.Section)
+	newSection, _ := inv.layout.Section(newSeat.Section)
+	oldFare, err := s.catalogue.Price(departure, oldSection, journey)
+	if err != nil {
+		return err
+	}
+	newFare, err := s.catalogue.Price(departure, newSection, journey)
+	if err != nil {
+		return err
+	}
+	if newFare != oldFare {
+		return preconditionError(preconditionFareDifference, newSeat.Label,
+			fmt.Sprintf("seat %s costs %s instead of %s, change to it with ChangeSeat", newSeat.Label, newFare, oldFare))
+	}
+	return nil
+}
+
+// departureAvailability is a departure with the seats left on it for a journey
+type departureAvailability struct {
+	departure *Departure
+	available int
+}
+
+// departures returns the departures on routeID (any route when empty) that
+// run on date (YYYY-MM-DD in UTC, any date when empty) and call at from and
+// to in that order, with the seats available on each for that journey or
+// the whole route when none is given
+func (s *server) departures(routeID, from, to, date string) []departureAvailability {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var departures []departureAvailability
+	for _, departure := range s.catalogue.Departures {
+		if routeID != "" && departure.Route != routeID {
+			continue
 		}
+		inv := s.trains[departure.ID]
+		journey := inv.allLegs()
+		if from != "" || to != "" {
+			var ok bool
+			if journey, ok = departure.Legs(from, to); !ok {
+				continue
+			}
+		}
+		if date != "" && departure.DepartsAt.UTC().Format(time.DateOnly) != date {
+			continue
+		}
+		departures = append(departures, departureAvailability{departure: departure, available: inv.available(journey)})
 	}
+	return departures
+}
+
+// loadCatalogue reads the catalogue file if one is given, or builds the
+// default single-train catalogue using the layout file if one is given
+func loadCatalogue(cataloguePath, layoutPath string) (*Catalogue, error) {
+	if cataloguePath != "" {
+		return LoadCatalogue(cataloguePath)
+	}
+
+	layout := DefaultLayout()
+	if layoutPath != "" {
+		var err error
+		if layout, err = LoadLayout(layoutPath); err != nil {
+			return nil, err
+		}
+	}
+	return DefaultCatalogue(layout), nil
+}
+
+// legacyServiceName is the name TicketService was served under before the
+// API moved to the versioned ticket.v1 package. The messages are the same on
+// the wire, so clients built from the old definitions are served by the v1
+// handlers.
+const legacyServiceName = "ticket.TicketService"
+
+// Helper function to register the v1 service under its legacy name as well
+func registerLegacyService(grpcServer *grpc.Server, srv pb.TicketServiceServer) {
+	desc := pb.TicketService_ServiceDesc
+	desc.ServiceName = legacyServiceName
+	grpcServer.RegisterService(&desc, srv)
 }
 
 func main() {
+	var storeCfg StoreConfig
+	flag.StringVar(&storeCfg.Kind, "store", "memory", "storage backend: memory, bolt or wal")
+	flag.StringVar(&storeCfg.BoltPath, "db", "tickets.db", "database file used by the bolt store")
+	flag.StringVar(&storeCfg.WALDir, "wal-dir", "journal", "journal and snapshot directory used by the wal store")
+	flag.IntVar(&storeCfg.SnapshotEvery, "snapshot-every", 100, "journal entries between snapshots of the wal store")
+	cataloguePath := flag.String("catalogue", "", "JSON catalogue of routes, departures and layouts (defaults to a single London to France train)")
+	layoutPath := flag.String("layout", "", "JSON coach layout file of the default train (defaults to sections A and B with two seats each)")
+	var opts Options
+	flag.DurationVar(&opts.HoldTTL, "hold-ttl", 10*time.Minute, "how long HoldSeat reserves a seat")
+	gatewayKind := flag.String("payment-gateway", "fake", "payment gateway: fake")
+	allocation := flag.String("allocation", "first-fit", "seat allocation strategy: first-fit, balance-sections, fill-from-back or random")
+	notifierKind := flag.String("notifier", "log", "how users are notified of seats allocated from the waitlist: log")
+	httpAddr := flag.String("http", "", "address of the HTTP/JSON gateway and the Connect and gRPC-Web protocols, e.g. :8080 (defaults to serving gRPC only)")
+	corsOrigins := flag.String("cors-origins", "", "comma-separated origins whose browser pages may call the HTTP port, or * for any (defaults to same-origin only)")
+	flag.Parse()
+	if *corsOrigins != "" && *httpAddr == "" {
+		log.Fatalf("-cors-origins applies to the HTTP port, which is only served with -http")
+	}
+
+	catalogue, err := loadCatalogue(*cataloguePath, *layoutPath)
+	if err != nil {
+		log.Fatalf("Failed to load catalogue: %v", err)
+	}
+
+	store, err := OpenStore(storeCfg)
+	if err != nil {
+		log.Fatalf("Failed to open %s store: %v", storeCfg.Kind, err)
+	}
+
+	payments, err := OpenGateway(*gatewayKind)
+	if err != nil {
+		log.Fatalf("Failed to open payment gateway: %v", err)
+	}
+
+	if opts.Allocation, err = AllocationStrategyByName(*allocation); err != nil {
+		log.Fatalf("Failed to choose allocation strategy: %v", err)
+	}
+
+	notifier, err := OpenNotifier(*notifierKind)
+	if err != nil {
+		log.Fatalf("Failed to open notifier: %v", err)
+	}
+
+	srv, err := NewServer(store, catalogue, payments, notifier, opts)
+	if err != nil {
+		log.Fatalf("Failed to load bookings: %v", err)
+	}
+
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterTicketServiceServer(grpcServer, NewServer())
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(errorInterceptor, validationInterceptor),
+		grpc.ChainStreamInterceptor(streamErrorInterceptor, streamValidationInterceptor),
+	)
+	v1 := &v1Service{core: srv}
+	pb.RegisterTicketServiceServer(grpcServer, v1)
+	registerLegacyService(grpcServer, v1)
+	pbv2.RegisterTicketServiceServer(grpcServer, &v2Service{core: srv})
+
+	// Serve the HTTP/JSON gateway and the Connect and gRPC-Web protocols,
+	// which forward to the gRPC port
+	gatewayCtx, stopGateway := context.WithCancel(context.Background())
+	defer stopGateway()
+	var httpServer *http.Server
+	if *httpAddr != "" {
+		_, port, _ := net.SplitHostPort(lis.Addr().String())
+		conn, err := grpc.NewClient(net.JoinHostPort("localhost", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to connect HTTP gateway: %v", err)
+		}
+		defer conn.Close()
+		handler, err := newHTTPHandler(gatewayCtx, conn, splitList(*corsOrigins))
+		if err != nil {
+			log.Fatalf("Failed to start HTTP gateway: %v", err)
+		}
+		httpServer = &http.Server{Addr: *httpAddr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			log.Printf("HTTP gateway is running at %s...", *httpAddr)
+			if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("Failed to serve HTTP: %v", err)
+			}
+		}()
+	}
+
+	// Stop gracefully on interrupt so the store can flush its state. The seat
+	// feed stops first to end WatchSeats streams, which would otherwise keep
+	// both servers waiting.
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		log.Println("Shutting down...")
+		srv.feed.stop()
+		if httpServer != nil {
+			if err := httpServer.Shutdown(context.Background()); err != nil {
+				log.Printf("Failed to stop HTTP gateway: %v", err)
+			}
+		}
+		grpcServer.GracefulStop()
+	}()
+
+	// Release expired seat holds in the background until the server stops
+	reaperCtx, stopReaper := context.WithCancel(context.Background())
+	reaperDone := make(chan struct{})
+	go func() {
+		defer close(reaperDone)
+		srv.reapHolds(reaperCtx, reapInterval(opts.HoldTTL))
+	}()
 
 	log.Println("Server is running at :50051...")
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+	stopReaper()
+	<-reaperDone
+	if err := store.Close(); err != nil {
+		log.Fatalf("Failed to close store: %v", err)
+	}
 }
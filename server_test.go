@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to build a catalogue with one departure of a single
+// two-stop route, whose standard-class sections Q and S have two seats each
+// and are priced by the given fares
+func testCatalogue(t *testing.T, fares ...*Fare) *Catalogue {
+	t.Helper()
+	catalogue := &Catalogue{
+		Layouts: map[string]*Layout{"coach": {Sections: []*SectionLayout{
+			{Name: "Q", Rows: 1, SeatsPerRow: 2},
+			{Name: "S", Rows: 1, SeatsPerRow: 2},
+		}}},
+		Routes:     []*Route{{ID: "R", Name: "Test", Stations: []string{"London", "France"}}},
+		Departures: []*Departure{{ID: "D", Route: "R", Layout: "coach"}},
+		Fares:      fares,
+	}
+	if err := catalogue.build(); err != nil {
+		t.Fatal(err)
+	}
+	return catalogue
+}
+
+// Helper function to start a server over an in-memory store
+func testServer(t *testing.T, catalogue *Catalogue) *server {
+	t.Helper()
+	srv, err := NewServer(NewMemoryStore(), catalogue, NewFakeGateway(), logNotifier{}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+// Helper function to book a seat in a section for a user
+func bookSection(t *testing.T, srv *server, section, email string) *pb.Receipt {
+	t.Helper()
+	receipt, err := srv.purchaseTicket(context.Background(), ticketOrder{
+		departureID: "D",
+		user:        &pb.User{FirstName: "Test", LastName: "User", Email: email},
+		preference:  seatPreference{section: section},
+	})
+	if err != nil {
+		t.Fatalf("book section %s: %v", section, err)
+	}
+	return receipt
+}
+
+// Helper function to read a booking as the server has stored it
+func getBooking(t *testing.T, srv *server, bookingID string) *pb.Receipt {
+	t.Helper()
+	receipt, err := srv.getBooking(bookingID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return receipt
+}
+
+// Helper function to read the type of the first precondition violation of an error
+func preconditionType(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if failure, ok := detail.(*errdetails.PreconditionFailure); ok && len(failure.Violations) > 0 {
+			return failure.Violations[0].Type
+		}
+	}
+	return ""
+}
+
+// failingStore is a Store whose nth write fails
+type failingStore struct {
+	Store
+	puts, failAt int
+}
+
+func (f *failingStore) PutReceipt(key string, receipt *pb.Receipt) error {
+	f.puts++
+	if f.puts == f.failAt {
+		return errors.New("disk full")
+	}
+	return f.Store.PutReceipt(key, receipt)
+}
+
+// failingGateway is a PaymentGateway that refuses every refund
+type failingGateway struct {
+	PaymentGateway
+}
+
+func (g failingGateway) Refund(ctx context.Context, transactionID string, amount Money) error {
+	return errors.New("provider unavailable")
+}
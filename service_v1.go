@@ -0,0 +1,376 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// v1Service serves ticket.v1.TicketService, and the legacy
+// ticket.TicketService, on top of the server. Its receipts are the server's
+// own records, so most handlers only unpack the request.
+type v1Service struct {
+	pb.UnimplementedTicketServiceServer
+	core *server
+}
+
+// Helper function to read the price a v1 client agreed to pay: price wins
+// over the legacy price_paid, which is read in the catalogue's currency,
+// and a request with neither accepts the fare
+func (v *v1Service) price(price *pb.Money, pricePaid float32) (*Money, error) {
+	switch {
+	case price != nil:
+		offered, err := MoneyFromProto(price)
+		if err != nil {
+			return nil, invalidArgumentError("price", err.Error())
+		}
+		return &offered, nil
+	case pricePaid != 0:
+		offered := MoneyFromLegacy(pricePaid, v.core.catalogue.Currency)
+		return &offered, nil
+	}
+	return nil, nil
+}
+
+// Helper function to convert a v1 seat preference
+func seatPreferenceV1(pref *pb.SeatPreference) seatPreference {
+	return seatPreference{
+		section:       pref.GetSection(),
+		seat:          pref.GetSeat(),
+		position:      seatPosition(pref.GetPosition()),
+		nearBookingID: pref.GetNearBookingId(),
+	}
+}
+
+// Helper function to convert a seat status to its v1 form
+func seatStatusV1(status seatStatus) pb.SeatStatus {
+	return pb.SeatStatus(status)
+}
+
+// PurchaseTicket allocates a seat on the requested departure and returns a receipt
+func (v *v1Service) PurchaseTicket(ctx context.Context, req *pb.PurchaseRequest) (*pb.Receipt, error) {
+	price, err := v.price(req.Price, req.PricePaid)
+	if err != nil {
+		return nil, err
+	}
+	return v.core.purchaseTicket(ctx, ticketOrder{
+		departureID:  req.DepartureId,
+		from:         req.From,
+		to:           req.To,
+		travelClass:  req.TravelClass,
+		user:         req.User,
+		preference:   seatPreferenceV1(req.Preference),
+		payment:      req.Payment.GetToken(),
+		price:        price,
+		joinWaitlist: req.JoinWaitlist,
+	})
+}
+
+// GetReceipt returns the receipt for a user by email
+func (v *v1Service) GetReceipt(ctx context.Context, req *pb.ReceiptRequest) (*pb.Receipt, error) {
+	return v.core.userReceipt(req.DepartureId, req.Email)
+}
+
+// GetAllocatedUsers returns users and their confirmed seats for a requested
+// section, with the part of the route each of them travels
+func (v *v1Service) GetAllocatedUsers(ctx context.Context, req *pb.SectionRequest) (*pb.UserList, error) {
+	receipts, err := v.core.sectionPassengers(req.DepartureId, req.Section)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*pb.UserSeatInfo
+	for _, receipt := range receipts {
+		users = append(users, &pb.UserSeatInfo{
+			User: receipt.User,
+			Seat: receipt.Seat,
+			From: receipt.From,
+			To:   receipt.To,
+		})
+	}
+	return &pb.UserList{UserSeats: users}, nil
+}
+
+// RemoveUser removes a user from a departure
+func (v *v1Service) RemoveUser(ctx context.Context, req *pb.RemoveRequest) (*pb.Response, error) {
+	receipt, err := v.core.removeUser(ctx, req.DepartureId, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Response{Message: "User removed successfully.", Refund: receipt.Refund}, nil
+}
+
+// ModifySeat modifies the seat of an existing user if the new seat is available
+func (v *v1Service) ModifySeat(ctx context.Context, req *pb.ModifyRequest) (*pb.Response, error) {
+	if err := v.core.modifyUserSeat(req.DepartureId, req.Email, req.NewSeat); err != nil {
+		return nil, err
+	}
+	return &pb.Response{Message: "Seat modified successfully."}, nil
+}
+
+// ListRoutes returns every route in the catalogue
+func (v *v1Service) ListRoutes(ctx context.Context, req *pb.RoutesRequest) (*pb.RouteList, error) {
+	var routes []*pb.Route
+	for _, route := range v.core.catalogue.Routes {
+		routes = append(routes, &pb.Route{
+			Id:       route.ID,
+			Name:     route.Name,
+			Stations: route.Stations,
+		})
+	}
+	return &pb.RouteList{Routes: routes}, nil
+}
+
+// ListDepartures returns the departures matching the request's filters,
+// with the number of seats still available on each for the requested
+// journey (or the whole route when no journey is given)
+func (v *v1Service) ListDepartures(ctx context.Context, req *pb.DeparturesRequest) (*pb.DepartureList, error) {
+	var departures []*pb.Departure
+	for _, d := range v.core.departures(req.RouteId, req.From, req.To, req.Date) {
+		info := &pb.Departure{
+			Id:             d.departure.ID,
+			RouteId:        d.departure.Route,
+			SeatsAvailable: int32(d.available),
+		}
+		if !d.departure.DepartsAt.IsZero() {
+			info.DepartsAt = timestamppb.New(d.departure.DepartsAt)
+		}
+		departures = append(departures, info)
+	}
+	return &pb.DepartureList{Departures: departures}, nil
+}
+
+// GetBooking returns the receipt of a booking
+func (v *v1Service) GetBooking(ctx context.Context, req *pb.BookingRequest) (*pb.Receipt, error) {
+	return v.core.getBooking(req.BookingId)
+}
+
+// CancelBooking cancels a booking and frees its seat
+func (v *v1Service) CancelBooking(ctx context.Context, req *pb.BookingRequest) (*pb.Response, error) {
+	receipt, err := v.core.cancel(ctx, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Response{Message: "Booking cancelled successfully.", Refund: receipt.Refund}, nil
+}
+
+// ModifyBooking moves a booking to another seat if the new seat is available
+func (v *v1Service) ModifyBooking(ctx context.Context, req *pb.ModifyBookingRequest) (*pb.Response, error) {
+	if err := v.core.modifyBooking(req.BookingId, req.NewSeat); err != nil {
+		return nil, err
+	}
+	return &pb.Response{Message: "Seat modified successfully."}, nil
+}
+
+// ListMyBookings returns every booking held by a user, earliest departure first
+func (v *v1Service) ListMyBookings(ctx context.Context, req *pb.MyBookingsRequest) (*pb.ReceiptList, error) {
+	receipts, err := v.core.userBookings(req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReceiptList{Receipts: receipts}, nil
+}
+
+// GetQuote prices a journey in every section of the departure (or the
+// requested section or travel class), with the seats still available in each
+func (v *v1Service) GetQuote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteList, error) {
+	list, err := v.core.quote(req.DepartureId, req.From, req.To, req.TravelClass, req.Section)
+	if err != nil {
+		return nil, err
+	}
+
+	var quotes []*pb.Quote
+	for _, q := range list.quotes {
+		quotes = append(quotes, &pb.Quote{
+			Section:        q.section.Name,
+			TravelClass:    q.section.Class,
+			Price:          q.fare.Float32(),
+			Fare:           q.fare.Proto(),
+			SeatsAvailable: int32(q.available),
+		})
+	}
+	return &pb.QuoteList{
+		DepartureId: list.departure.ID,
+		From:        list.from,
+		To:          list.to,
+		Quotes:      quotes,
+	}, nil
+}
+
+// HoldSeat reserves a seat for the configured hold TTL without confirming
+// it, so the customer can be shown the seat and pay before ConfirmHold
+func (v *v1Service) HoldSeat(ctx context.Context, req *pb.HoldRequest) (*pb.Receipt, error) {
+	return v.core.holdSeat(ticketOrder{
+		departureID: req.DepartureId,
+		from:        req.From,
+		to:          req.To,
+		travelClass: req.TravelClass,
+		user:        req.User,
+		preference:  seatPreference{seat: req.Seat},
+	})
+}
+
+// ConfirmHold takes payment for a live hold at the held fare and turns it
+// into a confirmed booking
+func (v *v1Service) ConfirmHold(ctx context.Context, req *pb.ConfirmHoldRequest) (*pb.Receipt, error) {
+	price, err := v.price(req.Price, 0)
+	if err != nil {
+		return nil, err
+	}
+	return v.core.confirmHold(ctx, req.BookingId, req.Payment.GetToken(), price)
+}
+
+// PurchaseGroup books every passenger of a group on the same journey, or
+// none of them, under one group reference paid for with one payment
+func (v *v1Service) PurchaseGroup(ctx context.Context, req *pb.GroupPurchaseRequest) (*pb.GroupReceipt, error) {
+	price, err := v.price(req.Price, 0)
+	if err != nil {
+		return nil, err
+	}
+	group, err := v.core.purchaseGroup(ctx, groupOrder{
+		departureID: req.DepartureId,
+		from:        req.From,
+		to:          req.To,
+		travelClass: req.TravelClass,
+		passengers:  req.Passengers,
+		payment:     req.Payment.GetToken(),
+		price:       price,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groupReceiptV1(group), nil
+}
+
+// GetGroup returns the bookings of a group purchase in seat order
+func (v *v1Service) GetGroup(ctx context.Context, req *pb.GroupRequest) (*pb.GroupReceipt, error) {
+	group, err := v.core.getGroup(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return groupReceiptV1(group), nil
+}
+
+// CancelGroup cancels every booking of a group purchase that is not
+// cancelled yet, refunding each under the refund policy
+func (v *v1Service) CancelGroup(ctx context.Context, req *pb.GroupRequest) (*pb.Response, error) {
+	_, refund, err := v.core.cancelGroup(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Response{Message: "Group cancelled successfully."}
+	if refund != nil {
+		resp.Refund = refund.Proto()
+	}
+	return resp, nil
+}
+
+// Helper function to convert a group booking to its v1 form
+func groupReceiptV1(group *groupBooking) *pb.GroupReceipt {
+	return &pb.GroupReceipt{GroupId: group.id, Receipts: group.receipts, Total: group.total.Proto()}
+}
+
+// GetSeatMap returns every seat of a departure with its status on the
+// requested journey. Unlike GetAllocatedUsers it says nothing about who
+// sits where, so it can be shown to any customer.
+func (v *v1Service) GetSeatMap(ctx context.Context, req *pb.SeatMapRequest) (*pb.SeatMap, error) {
+	seatMap, err := v.core.seatMap(req.DepartureId, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []*pb.SectionMap
+	for _, sm := range seatMap.sections {
+		seats := make([]*pb.SeatInfo, 0, len(sm.seats))
+		for _, state := range sm.seats {
+			seats = append(seats, &pb.SeatInfo{
+				Label:  state.seat.Label,
+				Row:    int32(state.seat.Row),
+				Column: int32(state.seat.Column),
+				Window: state.seat.Window,
+				Aisle:  state.seat.Aisle,
+				Status: seatStatusV1(state.status),
+			})
+		}
+		sections = append(sections, &pb.SectionMap{
+			Name:        sm.section.Name,
+			TravelClass: sm.section.Class,
+			Rows:        int32(sm.section.Rows),
+			SeatsPerRow: int32(sm.section.SeatsPerRow),
+			Fare:        sm.fare.Proto(),
+			Seats:       seats,
+		})
+	}
+	return &pb.SeatMap{
+		DepartureId: seatMap.departure.ID,
+		From:        seatMap.from,
+		To:          seatMap.to,
+		Sections:    sections,
+		Cursor:      seatMap.cursor,
+	}, nil
+}
+
+// WatchSeats streams every change to the seats of a departure as it
+// happens. A client that reconnects passes the cursor of the last event it
+// saw and gets the changes it missed first; one that starts from GetSeatMap
+// passes the map's cursor.
+func (v *v1Service) WatchSeats(req *pb.WatchSeatsRequest, stream grpc.ServerStreamingServer[pb.SeatEvent]) error {
+	return v.core.watchSeats(stream.Context(), req.DepartureId, req.Cursor, func(event *seatEvent) error {
+		return stream.Send(&pb.SeatEvent{
+			Cursor:      event.cursor,
+			DepartureId: event.departureID,
+			Seat:        event.seat,
+			Section:     event.section,
+			From:        event.from,
+			To:          event.to,
+			Status:      seatStatusV1(event.status),
+			Time:        timestamppb.New(event.time),
+		})
+	})
+}
+
+// SwapSeats offers to trade seats with another booking of the departure,
+// or accepts the offer that booking made
+func (v *v1Service) SwapSeats(ctx context.Context, req *pb.SwapRequest) (*pb.SwapResponse, error) {
+	receipt, swapped, err := v.core.swapSeats(ctx, req.BookingId, req.Email, req.OtherBookingId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.SwapResponse{Status: pb.SwapStatus_SWAP_STATUS_OFFERED, Receipt: receipt}
+	if swapped {
+		resp.Status = pb.SwapStatus_SWAP_STATUS_SWAPPED
+	}
+	return resp, nil
+}
+
+// ChangeSeat moves a booking to a seat that may be priced differently and
+// settles the fare difference
+func (v *v1Service) ChangeSeat(ctx context.Context, req *pb.ChangeSeatRequest) (*pb.SeatChange, error) {
+	price, err := v.price(req.Price, 0)
+	if err != nil {
+		return nil, err
+	}
+	change, err := v.core.changeSeat(ctx, seatChangeOrder{
+		bookingID: req.BookingId,
+		seat:      req.NewSeat,
+		section:   req.Section,
+		payment:   req.Payment.GetToken(),
+		price:     price,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SeatChange{Receipt: change.receipt}
+	if change.charged != nil {
+		resp.Charged = change.charged.Proto()
+	}
+	if change.refunded != nil {
+		resp.Refunded = change.refunded.Proto()
+	}
+	return resp, nil
+}
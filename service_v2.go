@@ -0,0 +1,419 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+	pbv2 "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v2"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// v2Service serves ticket.v2.TicketService on top of the server, converting
+// its messages to and from the v1 records the server keeps
+type v2Service struct {
+	pbv2.UnimplementedTicketServiceServer
+	core *server
+}
+
+// v2 enums start at an UNSPECIFIED zero value
+var (
+	bookingStatusesV2 = map[pb.BookingStatus]pbv2.BookingStatus{
+		pb.BookingStatus_BOOKING_STATUS_CONFIRMED:  pbv2.BookingStatus_BOOKING_STATUS_CONFIRMED,
+		pb.BookingStatus_BOOKING_STATUS_HELD:       pbv2.BookingStatus_BOOKING_STATUS_HELD,
+		pb.BookingStatus_BOOKING_STATUS_CANCELLED:  pbv2.BookingStatus_BOOKING_STATUS_CANCELLED,
+		pb.BookingStatus_BOOKING_STATUS_WAITLISTED: pbv2.BookingStatus_BOOKING_STATUS_WAITLISTED,
+	}
+	seatStatusesV2 = map[seatStatus]pbv2.SeatStatus{
+		seatFree:    pbv2.SeatStatus_SEAT_STATUS_FREE,
+		seatHeld:    pbv2.SeatStatus_SEAT_STATUS_HELD,
+		seatBooked:  pbv2.SeatStatus_SEAT_STATUS_BOOKED,
+		seatBlocked: pbv2.SeatStatus_SEAT_STATUS_BLOCKED,
+	}
+	seatPositionsV2 = map[pbv2.SeatPosition]seatPosition{
+		pbv2.SeatPosition_SEAT_POSITION_UNSPECIFIED: positionAny,
+		pbv2.SeatPosition_SEAT_POSITION_WINDOW:      positionWindow,
+		pbv2.SeatPosition_SEAT_POSITION_AISLE:       positionAisle,
+	}
+)
+
+// Helper function to read the price a v2 client agreed to pay, if any
+func priceV2(price *pbv2.Money) (*Money, error) {
+	if price == nil {
+		return nil, nil
+	}
+	offered, err := MoneyFromProto(&pb.Money{CurrencyCode: price.CurrencyCode, Units: price.Units, Nanos: price.Nanos})
+	if err != nil {
+		return nil, invalidArgumentError("price", err.Error())
+	}
+	return &offered, nil
+}
+
+// Helper function to convert money to its v2 form; nil stays nil
+func moneyV2(money *pb.Money) *pbv2.Money {
+	if money == nil {
+		return nil
+	}
+	return &pbv2.Money{CurrencyCode: money.CurrencyCode, Units: money.Units, Nanos: money.Nanos}
+}
+
+// Helper function to convert a v2 passenger to the server's user record
+func userFromV2(passenger *pbv2.Passenger) *pb.User {
+	return &pb.User{
+		FirstName: passenger.GetFirstName(),
+		LastName:  passenger.GetLastName(),
+		Email:     passenger.GetEmail(),
+	}
+}
+
+// Helper function to convert a v2 seat preference
+func seatPreferenceV2(pref *pbv2.SeatPreference) seatPreference {
+	return seatPreference{
+		section:       pref.GetSection(),
+		seat:          pref.GetSeat(),
+		position:      seatPositionsV2[pref.GetPosition()],
+		nearBookingID: pref.GetNearBookingId(),
+	}
+}
+
+// Helper function to convert a receipt to a v2 booking. The payment fields
+// are grouped under payment, which is left unset until the booking is paid for.
+func bookingV2(receipt *pb.Receipt) *pbv2.Booking {
+	booking := &pbv2.Booking{
+		BookingId:   receipt.BookingId,
+		DepartureId: receipt.DepartureId,
+		DepartsAt:   receipt.DepartsAt,
+		From:        receipt.From,
+		To:          receipt.To,
+		Passenger: &pbv2.Passenger{
+			FirstName: receipt.User.GetFirstName(),
+			LastName:  receipt.User.GetLastName(),
+			Email:     receipt.User.GetEmail(),
+		},
+		Seat:          receipt.Seat,
+		TravelClass:   receipt.TravelClass,
+		Price:         moneyV2(receipt.Price),
+		Status:        bookingStatusesV2[receipt.Status],
+		HoldExpiresAt: receipt.HoldExpiresAt,
+		WaitlistedAt:  receipt.WaitlistedAt,
+		CancelledAt:   receipt.CancelledAt,
+		GroupId:       receipt.GroupId,
+	}
+	if receipt.PaymentStatus != pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED {
+		// Payment statuses have the same numbers in both versions
+		booking.Payment = &pbv2.Payment{
+			Status:        pbv2.PaymentStatus(receipt.PaymentStatus),
+			TransactionId: receipt.TransactionId,
+			Refunded:      moneyV2(receipt.Refund),
+		}
+		for _, adjustment := range receipt.FareAdjustments {
+			booking.Payment.FareAdjustments = append(booking.Payment.FareAdjustments, &pbv2.FareAdjustment{
+				TransactionId: adjustment.TransactionId,
+				Amount:        moneyV2(adjustment.Amount),
+				Refunded:      moneyV2(adjustment.Refunded),
+			})
+		}
+	}
+	if receipt.SwapWith != "" {
+		booking.SwapOffer = &pbv2.SwapOffer{BookingId: receipt.SwapWith, Seat: receipt.SwapSeat}
+	}
+	if pref := receipt.Preference; pref != nil {
+		// Seat positions have the same numbers in both versions
+		booking.Preference = &pbv2.SeatPreference{
+			Section:       pref.Section,
+			Seat:          pref.Seat,
+			Position:      pbv2.SeatPosition(pref.Position),
+			NearBookingId: pref.NearBookingId,
+		}
+	}
+	return booking
+}
+
+// Helper function to convert a group booking to its v2 form
+func groupBookingV2(group *groupBooking) *pbv2.GroupBooking {
+	bookings := make([]*pbv2.Booking, 0, len(group.receipts))
+	for _, receipt := range group.receipts {
+		bookings = append(bookings, bookingV2(receipt))
+	}
+	return &pbv2.GroupBooking{GroupId: group.id, Bookings: bookings, Total: moneyV2(group.total.Proto())}
+}
+
+// ListRoutes returns every route in the catalogue
+func (v *v2Service) ListRoutes(ctx context.Context, req *pbv2.ListRoutesRequest) (*pbv2.ListRoutesResponse, error) {
+	resp := &pbv2.ListRoutesResponse{}
+	for _, route := range v.core.catalogue.Routes {
+		resp.Routes = append(resp.Routes, &pbv2.Route{
+			RouteId:  route.ID,
+			Name:     route.Name,
+			Stations: route.Stations,
+		})
+	}
+	return resp, nil
+}
+
+// ListDepartures returns the departures matching the request's filters,
+// with the number of seats still available on each for the requested
+// journey (or the whole route when no journey is given)
+func (v *v2Service) ListDepartures(ctx context.Context, req *pbv2.ListDeparturesRequest) (*pbv2.ListDeparturesResponse, error) {
+	resp := &pbv2.ListDeparturesResponse{}
+	for _, d := range v.core.departures(req.RouteId, req.From, req.To, req.Date) {
+		info := &pbv2.Departure{
+			DepartureId:    d.departure.ID,
+			RouteId:        d.departure.Route,
+			SeatsAvailable: int32(d.available),
+		}
+		if !d.departure.DepartsAt.IsZero() {
+			info.DepartsAt = timestamppb.New(d.departure.DepartsAt)
+		}
+		resp.Departures = append(resp.Departures, info)
+	}
+	return resp, nil
+}
+
+// GetQuote prices a journey in every section of the departure (or the
+// requested section or travel class), with the seats still available in each
+func (v *v2Service) GetQuote(ctx context.Context, req *pbv2.GetQuoteRequest) (*pbv2.GetQuoteResponse, error) {
+	list, err := v.core.quote(req.DepartureId, req.From, req.To, req.TravelClass, req.Section)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbv2.GetQuoteResponse{DepartureId: list.departure.ID, From: list.from, To: list.to}
+	for _, q := range list.quotes {
+		resp.Quotes = append(resp.Quotes, &pbv2.Quote{
+			Section:        q.section.Name,
+			TravelClass:    q.section.Class,
+			Fare:           moneyV2(q.fare.Proto()),
+			SeatsAvailable: int32(q.available),
+		})
+	}
+	return resp, nil
+}
+
+// GetSeatMap returns every seat of a departure with its status on the
+// requested journey, without the passengers in them
+func (v *v2Service) GetSeatMap(ctx context.Context, req *pbv2.GetSeatMapRequest) (*pbv2.GetSeatMapResponse, error) {
+	seatMap, err := v.core.seatMap(req.DepartureId, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbv2.GetSeatMapResponse{
+		DepartureId: seatMap.departure.ID,
+		From:        seatMap.from,
+		To:          seatMap.to,
+		Cursor:      seatMap.cursor,
+	}
+	for _, sm := range seatMap.sections {
+		seats := make([]*pbv2.SeatInfo, 0, len(sm.seats))
+		for _, state := range sm.seats {
+			seats = append(seats, &pbv2.SeatInfo{
+				Label:  state.seat.Label,
+				Row:    int32(state.seat.Row),
+				Column: int32(state.seat.Column),
+				Window: state.seat.Window,
+				Aisle:  state.seat.Aisle,
+				Status: seatStatusesV2[state.status],
+			})
+		}
+		resp.Sections = append(resp.Sections, &pbv2.SectionMap{
+			Name:        sm.section.Name,
+			TravelClass: sm.section.Class,
+			Rows:        int32(sm.section.Rows),
+			SeatsPerRow: int32(sm.section.SeatsPerRow),
+			Fare:        moneyV2(sm.fare.Proto()),
+			Seats:       seats,
+		})
+	}
+	return resp, nil
+}
+
+// WatchSeats streams every change to the seats of a departure as it
+// happens, resuming after the cursor if one is given
+func (v *v2Service) WatchSeats(req *pbv2.WatchSeatsRequest, stream grpc.ServerStreamingServer[pbv2.WatchSeatsResponse]) error {
+	return v.core.watchSeats(stream.Context(), req.DepartureId, req.Cursor, func(event *seatEvent) error {
+		return stream.Send(&pbv2.WatchSeatsResponse{
+			Cursor:      event.cursor,
+			DepartureId: event.departureID,
+			Seat:        event.seat,
+			Section:     event.section,
+			From:        event.from,
+			To:          event.to,
+			Status:      seatStatusesV2[event.status],
+			Time:        timestamppb.New(event.time),
+		})
+	})
+}
+
+// CreateBooking allocates a seat on the requested departure and pays for it
+func (v *v2Service) CreateBooking(ctx context.Context, req *pbv2.CreateBookingRequest) (*pbv2.CreateBookingResponse, error) {
+	price, err := priceV2(req.Price)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := v.core.purchaseTicket(ctx, ticketOrder{
+		departureID:  req.DepartureId,
+		from:         req.From,
+		to:           req.To,
+		travelClass:  req.TravelClass,
+		user:         userFromV2(req.Passenger),
+		preference:   seatPreferenceV2(req.Preference),
+		payment:      req.Payment.GetToken(),
+		price:        price,
+		joinWaitlist: req.JoinWaitlist,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.CreateBookingResponse{Booking: bookingV2(receipt)}, nil
+}
+
+// HoldSeat reserves a seat for the configured hold TTL without confirming
+// it, so the customer can be shown the seat and pay before ConfirmHold
+func (v *v2Service) HoldSeat(ctx context.Context, req *pbv2.HoldSeatRequest) (*pbv2.HoldSeatResponse, error) {
+	receipt, err := v.core.holdSeat(ticketOrder{
+		departureID: req.DepartureId,
+		from:        req.From,
+		to:          req.To,
+		travelClass: req.TravelClass,
+		user:        userFromV2(req.Passenger),
+		preference:  seatPreferenceV2(req.Preference),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.HoldSeatResponse{Booking: bookingV2(receipt)}, nil
+}
+
+// ConfirmHold takes payment for a live hold at the held fare and turns it
+// into a confirmed booking
+func (v *v2Service) ConfirmHold(ctx context.Context, req *pbv2.ConfirmHoldRequest) (*pbv2.ConfirmHoldResponse, error) {
+	price, err := priceV2(req.Price)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := v.core.confirmHold(ctx, req.BookingId, req.Payment.GetToken(), price)
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.ConfirmHoldResponse{Booking: bookingV2(receipt)}, nil
+}
+
+// GetBooking returns a booking
+func (v *v2Service) GetBooking(ctx context.Context, req *pbv2.GetBookingRequest) (*pbv2.GetBookingResponse, error) {
+	receipt, err := v.core.getBooking(req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.GetBookingResponse{Booking: bookingV2(receipt)}, nil
+}
+
+// ListBookings returns every booking of a passenger, earliest departure first
+func (v *v2Service) ListBookings(ctx context.Context, req *pbv2.ListBookingsRequest) (*pbv2.ListBookingsResponse, error) {
+	receipts, err := v.core.userBookings(req.Email)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbv2.ListBookingsResponse{}
+	for _, receipt := range receipts {
+		resp.Bookings = append(resp.Bookings, bookingV2(receipt))
+	}
+	return resp, nil
+}
+
+// CancelBooking cancels a booking and frees its seat
+func (v *v2Service) CancelBooking(ctx context.Context, req *pbv2.CancelBookingRequest) (*pbv2.CancelBookingResponse, error) {
+	receipt, err := v.core.cancel(ctx, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.CancelBookingResponse{Booking: bookingV2(receipt), Refund: moneyV2(receipt.Refund)}, nil
+}
+
+// ChangeSeat moves a booking to another seat, settling the fare difference
+func (v *v2Service) ChangeSeat(ctx context.Context, req *pbv2.ChangeSeatRequest) (*pbv2.ChangeSeatResponse, error) {
+	price, err := priceV2(req.Price)
+	if err != nil {
+		return nil, err
+	}
+	change, err := v.core.changeSeat(ctx, seatChangeOrder{
+		bookingID: req.BookingId,
+		seat:      req.NewSeat,
+		section:   req.Section,
+		payment:   req.Payment.GetToken(),
+		price:     price,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbv2.ChangeSeatResponse{Booking: bookingV2(change.receipt)}
+	if change.charged != nil {
+		resp.Charged = moneyV2(change.charged.Proto())
+	}
+	if change.refunded != nil {
+		resp.Refunded = moneyV2(change.refunded.Proto())
+	}
+	return resp, nil
+}
+
+// SwapSeats offers to trade seats with another booking of the departure,
+// or accepts the offer that booking made
+func (v *v2Service) SwapSeats(ctx context.Context, req *pbv2.SwapSeatsRequest) (*pbv2.SwapSeatsResponse, error) {
+	receipt, swapped, err := v.core.swapSeats(ctx, req.BookingId, req.Email, req.OtherBookingId)
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.SwapSeatsResponse{Swapped: swapped, Booking: bookingV2(receipt)}, nil
+}
+
+// CreateGroupBooking books every passenger of a group on the same journey,
+// or none of them, under one group reference paid for with one payment
+func (v *v2Service) CreateGroupBooking(ctx context.Context, req *pbv2.CreateGroupBookingRequest) (*pbv2.CreateGroupBookingResponse, error) {
+	price, err := priceV2(req.Price)
+	if err != nil {
+		return nil, err
+	}
+	passengers := make([]*pb.User, 0, len(req.Passengers))
+	for _, passenger := range req.Passengers {
+		passengers = append(passengers, userFromV2(passenger))
+	}
+	group, err := v.core.purchaseGroup(ctx, groupOrder{
+		departureID: req.DepartureId,
+		from:        req.From,
+		to:          req.To,
+		travelClass: req.TravelClass,
+		passengers:  passengers,
+		payment:     req.Payment.GetToken(),
+		price:       price,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.CreateGroupBookingResponse{Group: groupBookingV2(group)}, nil
+}
+
+// GetGroupBooking returns the bookings of a group in seat order
+func (v *v2Service) GetGroupBooking(ctx context.Context, req *pbv2.GetGroupBookingRequest) (*pbv2.GetGroupBookingResponse, error) {
+	group, err := v.core.getGroup(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.GetGroupBookingResponse{Group: groupBookingV2(group)}, nil
+}
+
+// CancelGroupBooking cancels every booking of a group that is not
+// cancelled yet, refunding each under the refund policy
+func (v *v2Service) CancelGroupBooking(ctx context.Context, req *pbv2.CancelGroupBookingRequest) (*pbv2.CancelGroupBookingResponse, error) {
+	group, refund, err := v.core.cancelGroup(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbv2.CancelGroupBookingResponse{Group: groupBookingV2(group)}
+	if refund != nil {
+		resp.Refund = moneyV2(refund.Proto())
+	}
+	return resp, nil
+}
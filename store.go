@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound is returned by a Store when no receipt exists for the given key
+var ErrNotFound = errors.New("receipt not found")
+
+// Store persists the receipts issued by the ticket service under keys chosen
+// by the server. Receipts are the source of truth: the seat occupancy kept by
+// the server is rebuilt from them when the server starts.
+type Store interface {
+	// Receipt returns the receipt stored under key, or ErrNotFound
+	Receipt(key string) (*pb.Receipt, error)
+	// Receipts returns every stored receipt
+	Receipts() ([]*pb.Receipt, error)
+	// PutReceipt inserts or replaces the receipt stored under key
+	PutReceipt(key string, receipt *pb.Receipt) error
+	// DeleteReceipt removes the receipt stored under key, or returns ErrNotFound
+	DeleteReceipt(key string) error
+	// Close releases any resources held by the store
+	Close() error
+}
+
+// StoreConfig selects and configures the storage backend at startup
+type StoreConfig struct {
+	Kind          string // "memory", "bolt" or "wal"
+	BoltPath      string // Database file of the bolt store
+	WALDir        string // Journal and snapshot directory of the wal store
+	SnapshotEvery int    // Journal entries between snapshots of the wal store
+}
+
+// OpenStore opens the storage backend selected at startup
+func OpenStore(cfg StoreConfig) (Store, error) {
+	switch cfg.Kind {
+	case "memory":
+		return NewMemoryStore(), nil
+	case "bolt":
+		return OpenBoltStore(cfg.BoltPath)
+	case "wal":
+		return OpenWALStore(cfg.WALDir, cfg.SnapshotEvery)
+	default:
+		return nil, fmt.Errorf("unknown store %q", cfg.Kind)
+	}
+}
+
+// memoryStore keeps receipts in a map; everything is lost when the process exits
+type memoryStore struct {
+	mu    sync.RWMutex
+	users map[string]*pb.Receipt // Map of receipts by key
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() Store {
+	return &memoryStore{users: make(map[string]*pb.Receipt)}
+}
+
+func (m *memoryStore) Receipt(key string) (*pb.Receipt, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	receipt, exists := m.users[key]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(receipt).(*pb.Receipt), nil
+}
+
+func (m *memoryStore) Receipts() ([]*pb.Receipt, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	receipts := make([]*pb.Receipt, 0, len(m.users))
+	for _, receipt := range m.users {
+		receipts = append(receipts, proto.Clone(receipt).(*pb.Receipt))
+	}
+	return receipts, nil
+}
+
+func (m *memoryStore) PutReceipt(key string, receipt *pb.Receipt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.users[key] = proto.Clone(receipt).(*pb.Receipt)
+	return nil
+}
+
+func (m *memoryStore) DeleteReceipt(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.users[key]; !exists {
+		return ErrNotFound
+	}
+	delete(m.users, key)
+	return nil
+}
+
+func (m *memoryStore) Close() error {
+	return nil
+}
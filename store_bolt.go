@@ -0,0 +1,94 @@
+package main
+
+import (
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var receiptsBucket = []byte("receipts")
+
+// boltStore keeps receipts in a single BoltDB file
+type boltStore struct {
+	db *bolt.DB
+}
+
+// OpenBoltStore opens (or creates) the BoltDB file at path
+func OpenBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(receiptsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &boltStore{db: db}, nil
+}
+
+func (b *boltStore) Receipt(key string) (*pb.Receipt, error) {
+	var receipt *pb.Receipt
+	err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(receiptsBucket).Get([]byte(key))
+		if data == nil {
+			return ErrNotFound
+		}
+		receipt = &pb.Receipt{}
+		return proto.Unmarshal(data, receipt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (b *boltStore) Receipts() ([]*pb.Receipt, error) {
+	var receipts []*pb.Receipt
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(receiptsBucket).ForEach(func(_, data []byte) error {
+			receipt := &pb.Receipt{}
+			if err := proto.Unmarshal(data, receipt); err != nil {
+				return err
+			}
+			receipts = append(receipts, receipt)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func (b *boltStore) PutReceipt(key string, receipt *pb.Receipt) error {
+	data, err := proto.Marshal(receipt)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(receiptsBucket).Put([]byte(key), data)
+	})
+}
+
+func (b *boltStore) DeleteReceipt(key string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(receiptsBucket)
+		if bucket.Get([]byte(key)) == nil {
+			return ErrNotFound
+		}
+		return bucket.Delete([]byte(key))
+	})
+}
+
+func (b *boltStore) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	journalFile  = "journal.log"
+	snapshotFile = "snapshot.json"
+)
+
+// journalEntry is one line of the write-ahead log
+type journalEntry struct {
+	Op      string          `json:"op"` // "put" or "delete"
+	Key     string          `json:"key"`
+	Receipt json.RawMessage `json:"receipt,omitempty"`
+}
+
+// snapshot is the full state written periodically so the journal can be truncated
+type snapshot struct {
+	Receipts map[string]json.RawMessage `json:"receipts"` // By key
+}
+
+// walStore keeps receipts in memory and makes every mutation durable by
+// appending it to a journal (and syncing it) before applying it. Every
+// snapshotEvery entries the whole state is written to a snapshot and the
+// journal is truncated. Opening the store loads the snapshot and replays the
+// journal on top of it.
+type walStore struct {
+	mu            sync.Mutex
+	mem           *memoryStore
+	dir           string
+	journal       *os.File
+	size          int64 // Length of the journal up to the last complete entry
+	entries       int   // Entries appended since the last snapshot
+	snapshotEvery int
+	broken        error // Set when a failed append could not be undone; the journal takes no more entries
+}
+
+// OpenWALStore opens (or creates) the journal and snapshot kept in dir and
+// replays them into memory
+func OpenWALStore(dir string, snapshotEvery int) (Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	w := &walStore{
+		mem:           NewMemoryStore().(*memoryStore),
+		dir:           dir,
+		snapshotEvery: snapshotEvery,
+	}
+	if err := w.loadSnapshot(); err != nil {
+		return nil, fmt.Errorf("load snapshot: %w", err)
+	}
+	if err := w.replayJournal(); err != nil {
+		return nil, fmt.Errorf("replay journal: %w", err)
+	}
+
+	journal, err := os.OpenFile(filepath.Join(dir, journalFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	w.journal = journal
+
+	return w, nil
+}
+
+func (w *walStore) loadSnapshot() error {
+	data, err := os.ReadFile(filepath.Join(w.dir, snapshotFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return err
+	}
+	for key, raw := range snap.Receipts {
+		receipt := &pb.Receipt{}
+		if err := protojson.Unmarshal(raw, receipt); err != nil {
+			return err
+		}
+		w.mem.users[key] = receipt
+	}
+	return nil
+}
+
+// replayJournal applies the journal to the loaded snapshot. A torn last line
+// left by a crash mid-append was never acknowledged, so it is cut off.
+func (w *walStore) replayJournal() error {
+	path := filepath.Join(w.dir, journalFile)
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				return os.Truncate(path, w.size) // Torn write at the tail
+			}
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var entry journalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("corrupt journal entry at offset %d: %w", w.size, err)
+		}
+		if err := w.apply(entry); err != nil {
+			return err
+		}
+		w.size += int64(len(line))
+		w.entries++
+	}
+}
+
+// apply performs a journal entry against the in-memory state
+func (w *walStore) apply(entry journalEntry) error {
+	switch entry.Op {
+	case "put":
+		receipt := &pb.Receipt{}
+		if err := protojson.Unmarshal(entry.Receipt, receipt); err != nil {
+			return err
+		}
+		w.mem.users[entry.Key] = receipt
+	case "delete":
+		delete(w.mem.users, entry.Key)
+	default:
+		return fmt.Errorf("unknown journal op %q", entry.Op)
+	}
+	return nil
+}
+
+// append writes entry to the journal and syncs it to disk. If either step
+// fails the entry is cut off again, so a partly written line cannot end up
+// in the middle of the journal once later entries follow it.
+func (w *walStore) append(entry journalEntry) error {
+	if w.broken != nil {
+		return w.broken
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	_, err = w.journal.Write(line)
+	if err == nil {
+		err = w.journal.Sync()
+	}
+	if err != nil {
+		if truncErr := w.journal.Truncate(w.size); truncErr != nil {
+			w.broken = fmt.Errorf("journal damaged by a failed append: %w", errors.Join(err, truncErr))
+			return w.broken
+		}
+		return err
+	}
+
+	w.size += int64(len(line))
+	w.entries++
+	return nil
+}
+
+// commit journals entry, applies it and takes a snapshot when one is due.
+// Once journaled the entry is durable, so a failed snapshot is only logged
+// and tried again on the next commit.
+func (w *walStore) commit(entry journalEntry) error {
+	if err := w.append(entry); err != nil {
+		return err
+	}
+	if err := w.apply(entry); err != nil {
+		return err
+	}
+	if w.snapshotEvery > 0 && w.entries >= w.snapshotEvery {
+		if err := w.snapshot(); err != nil {
+			log.Printf("Failed to snapshot the journal in %s, will retry: %v", w.dir, err)
+		}
+	}
+	return nil
+}
+
+// snapshot writes the full state atomically and truncates the journal
+func (w *walStore) snapshot() error {
+	snap := snapshot{Receipts: make(map[string]json.RawMessage)}
+	for key, receipt := range w.mem.users {
+		raw, err := protojson.Marshal(receipt)
+		if err != nil {
+			return err
+		}
+		snap.Receipts[key] = raw
+	}
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(w.dir, snapshotFile+".tmp")
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(w.dir, snapshotFile)); err != nil {
+		return err
+	}
+	// The rename only survives a crash once the directory is synced; until
+	// then the journal must stay
+	if err := syncDir(w.dir); err != nil {
+		return err
+	}
+
+	// Replaying the journal over the new snapshot is harmless, so a crash
+	// before the truncate below loses nothing
+	if err := w.journal.Truncate(0); err != nil {
+		return err
+	}
+	w.size, w.entries = 0, 0
+	return nil
+}
+
+// Helper function to sync a directory, making renames in it durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func (w *walStore) Receipt(key string) (*pb.Receipt, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.mem.Receipt(key)
+}
+
+func (w *walStore) Receipts() ([]*pb.Receipt, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.mem.Receipts()
+}
+
+func (w *walStore) PutReceipt(key string, receipt *pb.Receipt) error {
+	raw, err := protojson.Marshal(receipt)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.commit(journalEntry{Op: "put", Key: key, Receipt: raw})
+}
+
+func (w *walStore) DeleteReceipt(key string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, exists := w.mem.users[key]; !exists {
+		return ErrNotFound
+	}
+	return w.commit(journalEntry{Op: "delete", Key: key})
+}
+
+func (w *walStore) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.snapshot(); err != nil {
+		w.journal.Close()
+		return err
+	}
+	return w.journal.Close()
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+// walOp is a mutation applied to a walStore by the tests
+type walOp struct {
+	delete bool
+	key    string
+	seat   string
+}
+
+// Helper function to open a walStore in dir, failing the test on error
+func openTestWAL(t *testing.T, dir string, snapshotEvery int) *walStore {
+	t.Helper()
+	store, err := OpenWALStore(dir, snapshotEvery)
+	if err != nil {
+		t.Fatalf("OpenWALStore() error = %v", err)
+	}
+	return store.(*walStore)
+}
+
+// Helper function to stop using a walStore without the snapshot Close
+// takes, as if the process had crashed
+func crash(w *walStore) {
+	w.journal.Close()
+}
+
+// Helper function to list the receipts of a store as key=seat pairs
+func storedSeats(t *testing.T, store Store) []string {
+	t.Helper()
+	receipts, err := store.Receipts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seats []string
+	for _, receipt := range receipts {
+		seats = append(seats, receipt.BookingId+"="+receipt.Seat)
+	}
+	sort.Strings(seats)
+	return seats
+}
+
+func TestWALStoreReplay(t *testing.T) {
+	ops := []walOp{
+		{key: "A", seat: "A1"},
+		{key: "B", seat: "A2"},
+		{key: "A", seat: "B1"},
+		{delete: true, key: "B"},
+		{key: "C", seat: "B2"},
+	}
+	want := []string{"A=B1", "C=B2"}
+
+	tests := []struct {
+		name          string
+		snapshotEvery int
+	}{
+		{name: "journal only", snapshotEvery: 0},
+		{name: "snapshot after every entry", snapshotEvery: 1},
+		{name: "snapshot and journal tail", snapshotEvery: 2},
+		{name: "snapshot never due", snapshotEvery: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			w := openTestWAL(t, dir, tt.snapshotEvery)
+			for _, op := range ops {
+				var err error
+				if op.delete {
+					err = w.DeleteReceipt(op.key)
+				} else {
+					err = w.PutReceipt(op.key, &pb.Receipt{BookingId: op.key, Seat: op.seat})
+				}
+				if err != nil {
+					t.Fatalf("apply %+v: %v", op, err)
+				}
+			}
+			crash(w)
+
+			reopened := openTestWAL(t, dir, tt.snapshotEvery)
+			defer reopened.Close()
+			if got := storedSeats(t, reopened); strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("receipts after replay = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWALStoreJournalDamage(t *testing.T) {
+	tests := []struct {
+		name    string
+		tail    string // Appended to a journal holding receipt A
+		wantErr bool
+		want    []string
+	}{
+		{name: "clean", want: []string{"A=A1"}},
+		{name: "torn last entry", tail: `{"op":"put","key":"B","rec`, want: []string{"A=A1"}},
+		{name: "torn entry of a single byte", tail: `{`, want: []string{"A=A1"}},
+		{name: "corrupt complete entry", tail: "not json\n", wantErr: true},
+		{name: "unknown op", tail: `{"op":"drop","key":"A"}` + "\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			w := openTestWAL(t, dir, 0)
+			if err := w.PutReceipt("A", &pb.Receipt{BookingId: "A", Seat: "A1"}); err != nil {
+				t.Fatal(err)
+			}
+			crash(w)
+			path := filepath.Join(dir, journalFile)
+			journal, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			journal.WriteString(tt.tail)
+			journal.Close()
+
+			store, err := OpenWALStore(dir, 0)
+			if tt.wantErr {
+				if err == nil {
+					store.Close()
+					t.Fatal("OpenWALStore() succeeded on a corrupt journal")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OpenWALStore() error = %v", err)
+			}
+			if got := storedSeats(t, store); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("receipts = %v, want %v", got, tt.want)
+			}
+
+			// Entries appended after recovery must follow the last complete
+			// one, or the next replay would fail on the torn line
+			if err := store.PutReceipt("C", &pb.Receipt{BookingId: "C", Seat: "B1"}); err != nil {
+				t.Fatal(err)
+			}
+			crash(store.(*walStore))
+			reopened := openTestWAL(t, dir, 0)
+			defer reopened.Close()
+			want := append(tt.want, "C=B1")
+			if got := storedSeats(t, reopened); strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("receipts after a second replay = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWALStoreFailedSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWAL(t, dir, 1)
+
+	// A directory where the snapshot is written makes every snapshot fail
+	blocker := filepath.Join(dir, snapshotFile+".tmp")
+	if err := os.Mkdir(blocker, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.PutReceipt("A", &pb.Receipt{BookingId: "A", Seat: "A1"}); err != nil {
+		t.Fatalf("PutReceipt() error = %v, want the journaled entry to succeed", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, snapshotFile)); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("snapshot written despite the blocker: %v", err)
+	}
+
+	// The next commit takes the snapshot that failed
+	if err := os.Remove(blocker); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.PutReceipt("B", &pb.Receipt{BookingId: "B", Seat: "A2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, snapshotFile)); err != nil {
+		t.Errorf("snapshot not retried: %v", err)
+	}
+	crash(w)
+
+	reopened := openTestWAL(t, dir, 1)
+	defer reopened.Close()
+	if got, want := storedSeats(t, reopened), []string{"A=A1", "B=A2"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("receipts = %v, want %v", got, want)
+	}
+}
+
+func TestWALStoreFailedAppend(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWAL(t, dir, 0)
+	if err := w.PutReceipt("A", &pb.Receipt{BookingId: "A", Seat: "A1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A read-only handle fails the write and the truncate that would undo it
+	journal := w.journal
+	readOnly, err := os.Open(filepath.Join(dir, journalFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.journal = readOnly
+	if err := w.PutReceipt("B", &pb.Receipt{BookingId: "B", Seat: "A2"}); err == nil {
+		t.Fatal("PutReceipt() succeeded without a writable journal")
+	}
+	w.journal = journal
+	if err := w.PutReceipt("C", &pb.Receipt{BookingId: "C", Seat: "B1"}); err == nil {
+		t.Error("PutReceipt() succeeded after the journal could not be repaired")
+	}
+	if got, want := storedSeats(t, w), []string{"A=A1"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("receipts = %v, want %v", got, want)
+	}
+	readOnly.Close()
+	crash(w)
+
+	reopened := openTestWAL(t, dir, 0)
+	defer reopened.Close()
+	if got, want := storedSeats(t, reopened), []string{"A=A1"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("receipts after replay = %v, want %v", got, want)
+	}
+}
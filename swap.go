@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// swapSeats trades the seats of two bookings of a departure. Both holders
+// must agree: the first call records an offer on the caller's booking and
+// tells the other holder, and the swap happens when the other holder makes
+// the matching call. An offer is for the seats as they are, so moving either
+// booking to another seat withdraws it. It returns the caller's booking and
+// whether the seats were swapped.
+func (s *server) swapSeats(ctx context.Context, bookingID, email, otherBookingID string) (*pb.Receipt, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if bookingID == otherBookingID {
+		return nil, false, invalidArgumentError("other_booking_id", "cannot swap a booking with itself")
+	}
+	mine, err := s.swappableBooking(bookingID)
+	if err != nil {
+		return nil, false, err
+	}
+	if mine.User.Email != email {
+		return nil, false, permissionDeniedError(resourceBooking, mine.BookingId, "booking is not held by this user")
+	}
+	other, err := s.swappableBooking(otherBookingID)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.checkSwap(mine, other); err != nil {
+		return nil, false, err
+	}
+
+	if other.SwapWith == mine.BookingId && other.SwapSeat == mine.Seat {
+		if err := s.exchangeSeats(mine, other); err != nil {
+			return nil, false, err
+		}
+		return mine, true, nil
+	}
+
+	mine.SwapWith, mine.SwapSeat = other.BookingId, other.Seat
+	if err := s.store.PutReceipt(mine.BookingId, mine); err != nil {
+		return nil, false, err
+	}
+	message := fmt.Sprintf("booking %s offers to swap its seat %s on departure %s for seat %s of your booking %s; accept with SwapSeats",
+		mine.BookingId, mine.Seat, mine.DepartureId, other.Seat, other.BookingId)
+	if err := s.notifier.Notify(ctx, other.User, message); err != nil {
+		log.Printf("Failed to notify %s about swap offer %s: %v", other.User.Email, mine.BookingId, err)
+	}
+	return mine, false, nil
+}
+
+// Helper function to load a booking that can take part in a swap: only
+// confirmed bookings can, as held ones may expire before the other holder agrees
+func (s *server) swappableBooking(bookingID string) (*pb.Receipt, error) {
+	receipt, err := s.booking(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	switch receipt.Status {
+	case pb.BookingStatus_BOOKING_STATUS_CANCELLED:
+		return nil, preconditionError(preconditionCancelled, bookingID, "booking is cancelled")
+	case pb.BookingStatus_BOOKING_STATUS_WAITLISTED:
+		return nil, preconditionError(preconditionWaitlisted, bookingID, "booking has no seat yet")
+	case pb.BookingStatus_BOOKING_STATUS_HELD:
+		return nil, preconditionError(preconditionNotConfirmed, bookingID, "booking is held, confirm it before swapping")
+	}
+	return receipt, nil
+}
+
+// Helper function to check that two bookings can trade seats: they are on
+// the same departure in different seats of the same travel class, each seat
+// costs the other booking what it paid for its own, and each seat is free for
+// the other booking's journey once its own holder has left it
+func (s *server) checkSwap(a, b *pb.Receipt) error {
+	if a.DepartureId != b.DepartureId {
+		return invalidArgumentError("other_booking_id", "bookings are on different departures")
+	}
+	if a.Seat == b.Seat {
+		return preconditionError(preconditionSameSeat, b.Seat, "bookings are in the same seat")
+	}
+	inv := s.trains[a.DepartureId]
+	seatA, _ := inv.layout.Seat(a.Seat)
+	seatB, _ := inv.layout.Seat(b.Seat)
+	sectionA, _ := inv.layout.Section(seatA.Section)
+	sectionB, _ := inv.layout.Section(seatB.Section)
+	if sectionA.Class != sectionB.Class {
+		return preconditionError(preconditionClassMismatch, b.BookingId, "seats are of different travel classes")
+	}
+
+	// Each booking keeps the price it paid, so the other seat must cost the same for its journey
+	journeyA, _ := s.journey(a)
+	journeyB, _ := s.journey(b)
+	if err := s.checkSameFare(a, journeyA, seatA, seatB); err != nil {
+		return err
+	}
+	if err := s.checkSameFare(b, journeyB, seatB, seatA); err != nil {
+		return err
+	}
+	if !inv.vacantExcept(seatA, journeyB, a.BookingId) {
+		return alreadyExistsError(resourceSeat, seatA.Label, "seat is taken on part of the other journey")
+	}
+	if !inv.vacantExcept(seatB, journeyA, b.BookingId) {
+		return alreadyExistsError(resourceSeat, seatB.Label, "seat is taken on part of the other journey")
+	}
+	return nil
+}
+
+// Helper function to exchange the seats of two bookings checked with
+// checkSwap. Both receipts are stored before the seat map changes; if the
+// second write fails the first is put back, leaving both bookings as they were.
+func (s *server) exchangeSeats(a, b *pb.Receipt) error {
+	inv := s.trains[a.DepartureId]
+	seatA, _ := inv.layout.Seat(a.Seat)
+	seatB, _ := inv.layout.Seat(b.Seat)
+	journeyA, _ := s.journey(a)
+	journeyB, _ := s.journey(b)
+
+	before := proto.Clone(a).(*pb.Receipt)
+	a.Seat, a.SwapWith, a.SwapSeat = seatB.Label, "", ""
+	if err := s.store.PutReceipt(a.BookingId, a); err != nil {
+		return err
+	}
+	b.Seat, b.SwapWith, b.SwapSeat = seatA.Label, "", ""
+	if err := s.store.PutReceipt(b.BookingId, b); err != nil {
+		if err := s.store.PutReceipt(a.BookingId, before); err != nil {
+			log.Printf("Failed to restore booking %s after a failed swap: %v", a.BookingId, err)
+		}
+		return err
+	}
+
+	inv.vacate(seatA, journeyA)
+	inv.vacate(seatB, journeyB)
+	inv.occupy(seatB, journeyA, a.BookingId)
+	inv.occupy(seatA, journeyB, b.BookingId)
+	for _, seat := range []Seat{seatA, seatB} {
+		s.seatChanged(a.DepartureId, seat, journeyA)
+		if journeyB != journeyA {
+			s.seatChanged(a.DepartureId, seat, journeyB)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSwapSeatsRequiresSameFare(t *testing.T) {
+	tests := []struct {
+		name        string
+		fares       []*Fare
+		wantSwapped bool
+	}{
+		{
+			name:        "same fare",
+			fares:       []*Fare{{Route: "R", Base: 20 * nanosPerUnit}},
+			wantSwapped: true,
+		},
+		{
+			name: "sections priced differently",
+			fares: []*Fare{
+				{Route: "R", Section: "Q", Base: 100 * nanosPerUnit},
+				{Route: "R", Section: "S", Base: 10 * nanosPerUnit},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testServer(t, testCatalogue(t, tt.fares...))
+			dear := bookSection(t, srv, "Q", "dear@example.com")
+			cheap := bookSection(t, srv, "S", "cheap@example.com")
+			dearSeat, cheapSeat := dear.Seat, cheap.Seat
+
+			ctx := context.Background()
+			_, _, offerErr := srv.swapSeats(ctx, cheap.BookingId, "cheap@example.com", dear.BookingId)
+			var swapped bool
+			var err error
+			if offerErr == nil {
+				_, swapped, err = srv.swapSeats(ctx, dear.BookingId, "dear@example.com", cheap.BookingId)
+			} else {
+				err = offerErr
+			}
+
+			dear, cheap = getBooking(t, srv, dear.BookingId), getBooking(t, srv, cheap.BookingId)
+			if tt.wantSwapped {
+				if err != nil || !swapped {
+					t.Fatalf("swapSeats() = swapped %v, error %v, want swapped", swapped, err)
+				}
+				if dear.Seat != cheapSeat || cheap.Seat != dearSeat {
+					t.Errorf("seats after swap = %s and %s, want %s and %s", dear.Seat, cheap.Seat, cheapSeat, dearSeat)
+				}
+				return
+			}
+
+			if status.Code(err) != codes.FailedPrecondition {
+				t.Fatalf("swapSeats() error = %v, want FailedPrecondition", err)
+			}
+			if got := preconditionType(err); got != preconditionFareDifference {
+				t.Errorf("precondition violation = %q, want %q", got, preconditionFareDifference)
+			}
+			if dear.Seat != dearSeat || cheap.Seat != cheapSeat {
+				t.Errorf("seats changed to %s and %s after a refused swap", dear.Seat, cheap.Seat)
+			}
+			if got, _ := MoneyFromProto(cheap.Price); got.Amount != 10*nanosPerUnit {
+				t.Errorf("price of the cheap booking = %s, want GBP 10.00", got)
+			}
+		})
+	}
+}
@@ -1,277 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             v3.12.4
-// source: ticket.proto
-
-package ticket
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	TicketService_PurchaseTicket_FullMethodName    = "/ticket.TicketService/PurchaseTicket"
-	TicketService_GetReceipt_FullMethodName        = "/ticket.TicketService/GetReceipt"
-	TicketService_GetAllocatedUsers_FullMethodName = "/ticket.TicketService/GetAllocatedUsers"
-	TicketService_RemoveUser_FullMethodName        = "/ticket.TicketService/RemoveUser"
-	TicketService_ModifySeat_FullMethodName        = "/ticket.TicketService/ModifySeat"
-)
-
-// TicketServiceClient is the client API for TicketService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// Service definition
-type TicketServiceClient interface {
-	PurchaseTicket(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*Receipt, error)
-	GetReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
-	GetAllocatedUsers(ctx context.Context, in *SectionRequest, opts ...grpc.CallOption) (*UserList, error)
-	RemoveUser(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*Response, error)
-	ModifySeat(ctx context.Context, in *ModifyRequest, opts ...grpc.CallOption) (*Response, error)
-}
-
-type ticketServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewTicketServiceClient(cc grpc.ClientConnInterface) TicketServiceClient {
-	return &ticketServiceClient{cc}
-}
-
-func (c *ticketServiceClient) PurchaseTicket(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*Receipt, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Receipt)
-	err := c.cc.Invoke(ctx, TicketService_PurchaseTicket_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *ticketServiceClient) GetReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*Receipt, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Receipt)
-	err := c.cc.Invoke(ctx, TicketService_GetReceipt_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *ticketServiceClient) GetAllocatedUsers(ctx context.Context, in *SectionRequest, opts ...grpc.CallOption) (*UserList, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserList)
-	err := c.cc.Invoke(ctx, TicketService_GetAllocatedUsers_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *ticketServiceClient) RemoveUser(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*Response, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, TicketService_RemoveUser_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *ticketServiceClient) ModifySeat(ctx context.Context, in *ModifyRequest, opts ...grpc.CallOption) (*Response, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Response)
-	err := c.cc.Invoke(ctx, TicketService_ModifySeat_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// TicketServiceServer is the server API for TicketService service.
-// All implementations must embed UnimplementedTicketServiceServer
-// for forward compatibility.
-//
-// Service definition
-type TicketServiceServer interface {
-	PurchaseTicket(context.Context, *PurchaseRequest) (*Receipt, error)
-	GetReceipt(context.Context, *ReceiptRequest) (*Receipt, error)
-	GetAllocatedUsers(context.Context, *SectionRequest) (*UserList, error)
-	RemoveUser(context.Context, *RemoveRequest) (*Response, error)
-	ModifySeat(context.Context, *ModifyRequest) (*Response, error)
-	mustEmbedUnimplementedTicketServiceServer()
-}
-
-// UnimplementedTicketServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTicketServiceServer struct{}
-
-func (UnimplementedTicketServiceServer) PurchaseTicket(context.Context, *PurchaseRequest) (*Receipt, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurchaseTicket not implemented")
-}
-func (UnimplementedTicketServiceServer) GetReceipt(context.Context, *ReceiptRequest) (*Receipt, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
-}
-func (UnimplementedTicketServiceServer) GetAllocatedUsers(context.Context, *SectionRequest) (*UserList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllocatedUsers not implemented")
-}
-func (UnimplementedTicketServiceServer) RemoveUser(context.Context, *RemoveRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
-}
-func (UnimplementedTicketServiceServer) ModifySeat(context.Context, *ModifyRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifySeat not implemented")
-}
-func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
-func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
-
-// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TicketServiceServer will
-// result in compilation errors.
-type UnsafeTicketServiceServer interface {
-	mustEmbedUnimplementedTicketServiceServer()
-}
-
-func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTicketServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&TicketService_ServiceDesc, srv)
-}
-
-func _TicketService_PurchaseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TicketServiceServer).PurchaseTicket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TicketService_PurchaseTicket_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).PurchaseTicket(ctx, req.(*PurchaseRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _TicketService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReceiptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TicketServiceServer).GetReceipt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TicketService_GetReceipt_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).GetReceipt(ctx, req.(*ReceiptRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _TicketService_GetAllocatedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TicketServiceServer).GetAllocatedUsers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TicketService_GetAllocatedUsers_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).GetAllocatedUsers(ctx, req.(*SectionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _TicketService_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TicketServiceServer).RemoveUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TicketService_RemoveUser_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).RemoveUser(ctx, req.(*RemoveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _TicketService_ModifySeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TicketServiceServer).ModifySeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TicketService_ModifySeat_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).ModifySeat(ctx, req.(*ModifyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TicketService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ticket.TicketService",
-	HandlerType: (*TicketServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PurchaseTicket",
-			Handler:    _TicketService_PurchaseTicket_Handler,
-		},
-		{
-			MethodName: "GetReceipt",
-			Handler:    _TicketService_GetReceipt_Handler,
-		},
-		{
-			MethodName: "GetAllocatedUsers",
-			Handler:    _TicketService_GetAllocatedUsers_Handler,
-		},
-		{
-			MethodName: "RemoveUser",
-			Handler:    _TicketService_RemoveUser_Handler,
-		},
-		{
-			MethodName: "ModifySeat",
-			Handler:    _TicketService_ModifySeat_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ticket.proto",
-}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var patterns sync.Map // Compiled (ticket.rules).pattern expressions by source
+
+// validationInterceptor rejects requests that break the (ticket.rules)
+// declared on their fields with InvalidArgument, before they reach a handler
+func validationInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if msg, ok := req.(proto.Message); ok {
+		if err := validateRequest(msg); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// streamValidationInterceptor is validationInterceptor for the requests
+// received on streaming RPCs
+func streamValidationInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ss})
+}
+
+// validatingStream checks every message received on a stream
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return validateRequest(msg)
+	}
+	return nil
+}
+
+// validateRequest checks msg against its field rules and reports every
+// violation in a BadRequest detail
+func validateRequest(msg proto.Message) error {
+	violations := validateMessage(msg.ProtoReflect(), "")
+	if len(violations) == 0 {
+		return nil
+	}
+	return statusWithDetails(codes.InvalidArgument,
+		fmt.Sprintf("invalid %s: %s %s", msg.ProtoReflect().Descriptor().Name(), violations[0].Field, violations[0].Description),
+		&errdetails.BadRequest{FieldViolations: violations})
+}
+
+// validateMessage checks the fields of msg, and of the messages nested in
+// it, naming them relative to prefix
+func validateMessage(msg protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		violate := func(format string, args ...any) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       path,
+				Description: fmt.Sprintf(format, args...),
+			})
+		}
+
+		rules := fieldRules(fd)
+		if rules != nil && fd.IsList() {
+			count := msg.Get(fd).List().Len()
+			if rules.MinItems > 0 && count < int(rules.MinItems) {
+				violate("must have at least %d items", rules.MinItems)
+			}
+			if rules.MaxItems > 0 && count > int(rules.MaxItems) {
+				violate("must have at most %d items", rules.MaxItems)
+			}
+		}
+		if rules != nil && !fd.IsList() && !fd.IsMap() {
+			value := msg.Get(fd)
+			switch fd.Kind() {
+			case protoreflect.StringKind:
+				validateString(value.String(), rules, violate)
+			case protoreflect.MessageKind:
+				if rules.Required && !msg.Has(fd) {
+					violate("is required")
+				}
+			case protoreflect.FloatKind, protoreflect.DoubleKind:
+				if rules.NonNegative && value.Float() < 0 {
+					violate("must not be negative")
+				}
+			case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+				if rules.NonNegative && value.Int() < 0 {
+					violate("must not be negative")
+				}
+			}
+		}
+
+		// Check nested messages that are present
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsMap() && msg.Has(fd) {
+			if fd.IsList() {
+				list := msg.Get(fd).List()
+				for j := 0; j < list.Len(); j++ {
+					violations = append(violations, validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j))...)
+				}
+			} else {
+				violations = append(violations, validateMessage(msg.Get(fd).Message(), path+".")...)
+			}
+		}
+	}
+	return violations
+}
+
+// validateString applies rules to a string field
+func validateString(value string, rules *pb.FieldRules, violate func(format string, args ...any)) {
+	if value == "" {
+		if rules.Required {
+			violate("is required")
+		}
+		return
+	}
+
+	length := utf8.RuneCountInString(value)
+	if rules.MinLen > 0 && length < int(rules.MinLen) {
+		violate("must be at least %d characters", rules.MinLen)
+	}
+	if rules.MaxLen > 0 && length > int(rules.MaxLen) {
+		violate("must be at most %d characters", rules.MaxLen)
+	}
+	if rules.Email {
+		if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+			violate("must be a valid email address")
+		}
+	}
+	if rules.Pattern != "" && !pattern(rules.Pattern).MatchString(value) {
+		violate("must match %s", rules.Pattern)
+	}
+}
+
+// fieldRules returns the (ticket.rules) option of a field, or nil
+func fieldRules(fd protoreflect.FieldDescriptor) *pb.FieldRules {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil || !proto.HasExtension(opts, pb.E_Rules) {
+		return nil
+	}
+	return proto.GetExtension(opts, pb.E_Rules).(*pb.FieldRules)
+}
+
+// pattern returns the compiled form of expr. The expressions come from the
+// compiled-in proto definitions, so a bad one is a programming error.
+func pattern(expr string) *regexp.Regexp {
+	if re, ok := patterns.Load(expr); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(expr)
+	patterns.Store(expr, re)
+	return re
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Helper function to list the fields named in the BadRequest detail of an error
+func violatedFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	user := &pb.User{FirstName: "Test", LastName: "User", Email: "user@example.com"}
+	tests := []struct {
+		name       string
+		req        proto.Message
+		wantFields string // Violated fields, empty for a valid request
+	}{
+		{name: "valid purchase", req: &pb.PurchaseRequest{User: user}},
+		{name: "nil user", req: &pb.PurchaseRequest{}, wantFields: "user"},
+		{
+			name:       "empty new seat",
+			req:        &pb.ModifyRequest{Email: "user@example.com"},
+			wantFields: "new_seat",
+		},
+		{
+			name:       "bad email",
+			req:        &pb.ReceiptRequest{Email: "not an email"},
+			wantFields: "email",
+		},
+		{
+			name:       "email with a display name",
+			req:        &pb.ReceiptRequest{Email: "User <user@example.com>"},
+			wantFields: "email",
+		},
+		{
+			name:       "over-long field",
+			req:        &pb.PurchaseRequest{User: user, DepartureId: strings.Repeat("x", 101)},
+			wantFields: "departure_id",
+		},
+		{
+			name: "nested user",
+			req: &pb.PurchaseRequest{User: &pb.User{
+				FirstName: strings.Repeat("x", 101),
+				Email:     "user@example.com",
+			}},
+			wantFields: "user.first_name user.last_name",
+		},
+		{
+			name: "passenger of a group",
+			req: &pb.GroupPurchaseRequest{Passengers: []*pb.User{
+				user,
+				{FirstName: "Test", LastName: "User", Email: "nobody"},
+			}},
+			wantFields: "passengers[1].email",
+		},
+		{name: "no passengers", req: &pb.GroupPurchaseRequest{}, wantFields: "passengers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return req, nil
+			}
+			_, err := validationInterceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{}, handler)
+			if tt.wantFields == "" {
+				if err != nil || !called {
+					t.Errorf("valid request: error = %v, handler called %v", err, called)
+				}
+				return
+			}
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("error = %v, want InvalidArgument", err)
+			}
+			if called {
+				t.Error("handler called with an invalid request")
+			}
+			if got := strings.Join(violatedFields(err), " "); got != tt.wantFields {
+				t.Errorf("violated fields = %q, want %q", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+// recvStream is a server stream that receives one request
+type recvStream struct {
+	grpc.ServerStream
+	req proto.Message
+}
+
+func (s *recvStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestStreamValidationInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *pb.WatchSeatsRequest
+		wantFields string
+	}{
+		{name: "valid", req: &pb.WatchSeatsRequest{DepartureId: "D"}},
+		{name: "over-long cursor", req: &pb.WatchSeatsRequest{Cursor: strings.Repeat("x", 101)}, wantFields: "cursor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(srv any, stream grpc.ServerStream) error {
+				return stream.RecvMsg(&pb.WatchSeatsRequest{})
+			}
+			err := streamValidationInterceptor(nil, &recvStream{req: tt.req}, &grpc.StreamServerInfo{}, handler)
+			if tt.wantFields == "" {
+				if err != nil {
+					t.Errorf("error = %v, want none", err)
+				}
+				return
+			}
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("error = %v, want InvalidArgument", err)
+			}
+			if got := strings.Join(violatedFields(err), " "); got != tt.wantFields {
+				t.Errorf("violated fields = %q, want %q", got, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Helper function to put user on the waitlist of a departure for a journey
+// that has no seat left. The booking gets a reference but no seat or price
+// until one is allocated; the section, seat and position preferred are kept
+// for allocating it.
+func (s *server) joinWaitlist(departureID, from, to, travelClass string, pref seatPreference, user *pb.User) (*pb.Receipt, error) {
+	departure, _, err := s.train(departureID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, _, err = resolveJourney(departure, from, to)
+	if err != nil {
+		return nil, err
+	}
+	bookingID, err := s.newBookingID()
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &pb.Receipt{
+		From:         from,
+		To:           to,
+		User:         user,
+		DepartureId:  departure.ID,
+		BookingId:    bookingID,
+		TravelClass:  travelClass,
+		Status:       pb.BookingStatus_BOOKING_STATUS_WAITLISTED,
+		WaitlistedAt: timestamppb.Now(),
+	}
+	if pref.section != "" || pref.seat != "" || pref.position != positionAny {
+		receipt.Preference = &pb.SeatPreference{
+			Section:  pref.section,
+			Seat:     pref.seat,
+			Position: pb.SeatPosition(pref.position),
+		}
+	}
+	if !departure.DepartsAt.IsZero() {
+		receipt.DepartsAt = timestamppb.New(departure.DepartsAt)
+	}
+	if err := s.store.PutReceipt(bookingID, receipt); err != nil {
+		return nil, err
+	}
+
+	s.waitlist[departure.ID] = append(s.waitlist[departure.ID], bookingID)
+	s.byEmail[user.Email] = append(s.byEmail[user.Email], bookingID)
+	return receipt, nil
+}
+
+// Helper function to take a booking off its departure's waitlist
+func (s *server) leaveWaitlist(receipt *pb.Receipt) {
+	queue := s.waitlist[receipt.DepartureId]
+	for i, id := range queue {
+		if id == receipt.BookingId {
+			s.waitlist[receipt.DepartureId] = append(queue[:i:i], queue[i+1:]...)
+			break
+		}
+	}
+	if len(s.waitlist[receipt.DepartureId]) == 0 {
+		delete(s.waitlist, receipt.DepartureId)
+	}
+}
+
+// Helper function to sort the waitlists rebuilt from the store into the
+// order their bookings joined
+func (s *server) sortWaitlists() error {
+	for _, queue := range s.waitlist {
+		joined := make(map[string]time.Time, len(queue))
+		for _, id := range queue {
+			receipt, err := s.store.Receipt(id)
+			if err != nil {
+				return err
+			}
+			joined[id] = receipt.WaitlistedAt.AsTime()
+		}
+		sort.SliceStable(queue, func(i, j int) bool {
+			a, b := joined[queue[i]], joined[queue[j]]
+			if !a.Equal(b) {
+				return a.Before(b)
+			}
+			return queue[i] < queue[j]
+		})
+	}
+	return nil
+}
+
+// Helper function to offer seats freed on a departure to its waitlist, in
+// the order users joined. Each user who now fits gets the seat as a hold
+// and is told to confirm it; users whose journey still has no seat, or none
+// in the section, seat or position they asked for, keep their place.
+// Failures are logged, as the cancellation that freed the seat has already
+// happened.
+func (s *server) promoteWaitlist(ctx context.Context, departureID string) {
+	queue := s.waitlist[departureID]
+	for i := 0; i < len(queue); {
+		receipt, err := s.store.Receipt(queue[i])
+		if err != nil {
+			log.Printf("Failed to load waitlisted booking %s: %v", queue[i], err)
+			i++
+			continue
+		}
+		pref := seatPreference{
+			section:  receipt.Preference.GetSection(),
+			seat:     receipt.Preference.GetSeat(),
+			position: seatPosition(receipt.Preference.GetPosition()),
+		}
+		a, err := s.allocate(receipt.DepartureId, receipt.From, receipt.To, receipt.TravelClass, pref)
+		if err != nil {
+			i++
+			continue
+		}
+
+		expiry := time.Now().Add(s.opts.HoldTTL)
+		a.assign(receipt)
+		receipt.Preference = nil
+		receipt.Status = pb.BookingStatus_BOOKING_STATUS_HELD
+		receipt.HoldExpiresAt = timestamppb.New(expiry)
+		if err := s.store.PutReceipt(receipt.BookingId, receipt); err != nil {
+			log.Printf("Failed to allocate a seat to waitlisted booking %s: %v", receipt.BookingId, err)
+			break
+		}
+		a.inv.occupy(a.seat, a.journey, receipt.BookingId)
+		s.holds[receipt.BookingId] = expiry
+		s.seatChanged(receipt.DepartureId, a.seat, a.journey)
+		queue = append(queue[:i:i], queue[i+1:]...)
+
+		message := fmt.Sprintf("seat %s on departure %s is held for booking %s at %s until %s; confirm it with ConfirmHold",
+			receipt.Seat, receipt.DepartureId, receipt.BookingId, a.fare, expiry.Format(time.RFC3339))
+		if err := s.notifier.Notify(ctx, receipt.User, message); err != nil {
+			log.Printf("Failed to notify %s about booking %s: %v", receipt.User.Email, receipt.BookingId, err)
+		}
+	}
+
+	if len(queue) == 0 {
+		delete(s.waitlist, departureID)
+	} else {
+		s.waitlist[departureID] = queue
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/chandankumar2517/TrainTicketingSystem/gen/ticket/v1"
+)
+
+func TestPromoteWaitlistKeepsPreference(t *testing.T) {
+	ctx := context.Background()
+	srv := testServer(t, testCatalogue(t, &Fare{Route: "R", Base: 20 * nanosPerUnit}))
+	q1 := bookSection(t, srv, "Q", "q1@example.com")
+	bookSection(t, srv, "Q", "q2@example.com")
+	s1 := bookSection(t, srv, "S", "s1@example.com")
+	bookSection(t, srv, "S", "s2@example.com")
+
+	waiting, err := srv.purchaseTicket(ctx, ticketOrder{
+		departureID:  "D",
+		user:         &pb.User{FirstName: "Test", LastName: "User", Email: "waiting@example.com"},
+		preference:   seatPreference{section: "S"},
+		joinWaitlist: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if waiting.Status != pb.BookingStatus_BOOKING_STATUS_WAITLISTED {
+		t.Fatalf("status = %s, want waitlisted", waiting.Status)
+	}
+	if got := bookingV2(getBooking(t, srv, waiting.BookingId)).Preference.GetSection(); got != "S" {
+		t.Errorf("v2 booking prefers section %q, want S", got)
+	}
+
+	steps := []struct {
+		cancel     string
+		wantStatus pb.BookingStatus
+		wantSeat   string
+	}{
+		{cancel: q1.BookingId, wantStatus: pb.BookingStatus_BOOKING_STATUS_WAITLISTED},
+		{cancel: s1.BookingId, wantStatus: pb.BookingStatus_BOOKING_STATUS_HELD, wantSeat: s1.Seat},
+	}
+	for _, step := range steps {
+		if _, err := srv.cancel(ctx, step.cancel); err != nil {
+			t.Fatal(err)
+		}
+		got := getBooking(t, srv, waiting.BookingId)
+		if got.Status != step.wantStatus || got.Seat != step.wantSeat {
+			t.Errorf("after cancelling %s: status %s in seat %q, want %s in seat %q",
+				step.cancel, got.Status, got.Seat, step.wantStatus, step.wantSeat)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// seatFeedRetention is how many seat events are kept for watchers resuming
+// from a cursor
+const seatFeedRetention = 1024
+
+// seatEvent is a change to the status of a seat on the legs from one
+// station to another
+type seatEvent struct {
+	cursor      string // Position of the event in the feed
+	departureID string
+	seat        string
+	section     string
+	from, to    string
+	status      seatStatus
+	time        time.Time
+}
+
+// seatFeed is the log of recent seat changes that WatchSeats streams from.
+// Cursors name a position in it as "<epoch>-<sequence>"; the epoch changes
+// on every start, as the feed is not persisted.
+type seatFeed struct {
+	mu      sync.Mutex
+	epoch   string
+	last    uint64        // Sequence number of the latest event, 0 before any
+	events  []*seatEvent  // The most recent events, oldest first
+	changed chan struct{} // Closed and replaced when an event is published
+	stopped bool          // Set on shutdown, which ends every watch
+}
+
+func newSeatFeed() *seatFeed {
+	return &seatFeed{
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		changed: make(chan struct{}),
+	}
+}
+
+// cursor names the position after the latest event
+func (f *seatFeed) cursor() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.cursorAt(f.last)
+}
+
+func (f *seatFeed) cursorAt(seq uint64) string {
+	return fmt.Sprintf("%s-%d", f.epoch, seq)
+}
+
+// position parses a cursor into a sequence number. An empty cursor is the
+// position after the latest event.
+func (f *seatFeed) position(cursor string) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if cursor == "" {
+		return f.last, nil
+	}
+	epoch, number, ok := strings.Cut(cursor, "-")
+	seq, err := strconv.ParseUint(number, 10, 64)
+	if !ok || err != nil {
+		return 0, invalidArgumentError("cursor", "invalid cursor")
+	}
+	if epoch != f.epoch || seq > f.last {
+		return 0, preconditionError(preconditionCursorExpired, cursor, "cursor is from before the server restarted, reload the seat map")
+	}
+	return seq, nil
+}
+
+// publish appends event to the feed and wakes up the watchers
+func (f *seatFeed) publish(event *seatEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.last++
+	event.cursor = f.cursorAt(f.last)
+	f.events = append(f.events, event)
+	if len(f.events) > seatFeedRetention {
+		f.events = f.events[len(f.events)-seatFeedRetention:]
+	}
+	if !f.stopped {
+		close(f.changed)
+		f.changed = make(chan struct{})
+	}
+}
+
+// stop ends every watch, so the server can shut down gracefully
+func (f *seatFeed) stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.stopped {
+		f.stopped = true
+		close(f.changed)
+	}
+}
+
+// after returns the events published after position seq, and a channel
+// closed when the next event is published
+func (f *seatFeed) after(seq uint64) ([]*seatEvent, <-chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.stopped {
+		return nil, nil, status.Error(codes.Unavailable, "server is shutting down")
+	}
+	first := f.last - uint64(len(f.events)) + 1 // Sequence number of events[0]
+	if seq+1 < first {
+		return nil, nil, preconditionError(preconditionCursorExpired, f.cursorAt(seq), "cursor is too old, reload the seat map")
+	}
+	return f.events[seq+1-first:], f.changed, nil
+}
+
+// Helper function to publish the status of seat on the legs of journey
+// after it changed. Must be called once the bookings and holds are updated.
+func (s *server) seatChanged(departureID string, seat Seat, journey legs) {
+	departure, _ := s.catalogue.Departure(departureID)
+	s.feed.publish(&seatEvent{
+		departureID: departure.ID,
+		seat:        seat.Label,
+		section:     seat.Section,
+		from:        departure.route.Stations[journey.from],
+		to:          departure.route.Stations[journey.to],
+		status:      s.seatStatus(s.trains[departure.ID], seat, journey),
+		time:        time.Now(),
+	})
+}
+
+// watchSeats passes every change to the seats of a departure to send as it
+// happens, until ctx is done or send fails. A client that reconnects passes
+// the cursor of the last event it saw and gets the changes it missed first;
+// one that starts from a seat map passes the map's cursor.
+func (s *server) watchSeats(ctx context.Context, departureID, cursor string, send func(*seatEvent) error) error {
+	departure, ok := s.catalogue.Departure(departureID)
+	if !ok {
+		return notFoundError(resourceDeparture, departureID, "departure not found")
+	}
+	seq, err := s.feed.position(cursor)
+	if err != nil {
+		return err
+	}
+
+	for {
+		events, changed, err := s.feed.after(seq)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			seq++
+			if event.departureID != departure.ID {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchSeatsCursor(t *testing.T) {
+	tests := []struct {
+		name     string
+		cursor   func(t *testing.T, before, srv *server) string // before is the server srv restarted from
+		wantCode codes.Code
+		wantType string
+	}{
+		{
+			name: "seat map after the restart",
+			cursor: func(t *testing.T, before, srv *server) string {
+				return watchCursor(t, srv)
+			},
+			wantCode: codes.Canceled,
+		},
+		{
+			name: "seat map before the restart",
+			cursor: func(t *testing.T, before, srv *server) string {
+				// The restarted feed reaches the same sequence number
+				cursor := watchCursor(t, before)
+				bookSection(t, srv, "Q", "restarted@example.com")
+				return cursor
+			},
+			wantCode: codes.FailedPrecondition,
+			wantType: preconditionCursorExpired,
+		},
+		{
+			name: "ahead of the feed",
+			cursor: func(t *testing.T, before, srv *server) string {
+				return srv.feed.cursorAt(100)
+			},
+			wantCode: codes.FailedPrecondition,
+			wantType: preconditionCursorExpired,
+		},
+		{
+			name: "older than the feed keeps",
+			cursor: func(t *testing.T, before, srv *server) string {
+				cursor := watchCursor(t, srv)
+				for range seatFeedRetention + 1 {
+					srv.feed.publish(&seatEvent{departureID: "D"})
+				}
+				return cursor
+			},
+			wantCode: codes.FailedPrecondition,
+			wantType: preconditionCursorExpired,
+		},
+		{
+			name: "malformed",
+			cursor: func(t *testing.T, before, srv *server) string {
+				return "not a cursor"
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogue := testCatalogue(t, &Fare{Route: "R", Base: 20 * nanosPerUnit})
+			store := NewMemoryStore()
+			before, err := NewServer(store, catalogue, NewFakeGateway(), logNotifier{}, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			bookSection(t, before, "Q", "before@example.com")
+			srv, err := NewServer(store, catalogue, NewFakeGateway(), logNotifier{}, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cursor := tt.cursor(t, before, srv)
+
+			// A watch that gets going sees the next booking and is then cancelled
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.wantCode == codes.Canceled {
+				bookSection(t, srv, "S", "after@example.com")
+			}
+			var events []*seatEvent
+			err = srv.watchSeats(ctx, "D", cursor, func(event *seatEvent) error {
+				events = append(events, event)
+				cancel()
+				return nil
+			})
+
+			if tt.wantCode == codes.Canceled {
+				if !errors.Is(err, context.Canceled) {
+					t.Fatalf("watchSeats() error = %v, want it to run until cancelled", err)
+				}
+				if len(events) == 0 || events[0].section != "S" {
+					t.Errorf("events = %v, want the booking in section S", events)
+				}
+				return
+			}
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("watchSeats() error = %v, want %s", err, tt.wantCode)
+			}
+			if got := preconditionType(err); got != tt.wantType {
+				t.Errorf("precondition violation = %q, want %q", got, tt.wantType)
+			}
+			if len(events) != 0 {
+				t.Errorf("sent %d events from a refused cursor", len(events))
+			}
+		})
+	}
+}
+
+// Helper function to read the cursor of a departure's seat map
+func watchCursor(t *testing.T, srv *server) string {
+	t.Helper()
+	seats, err := srv.seatMap("D", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return seats.cursor
+}